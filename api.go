@@ -0,0 +1,55 @@
+package paystack
+
+//go:generate go run ./internal/cmd/apigen
+
+// The service interfaces in api_gen.go and the mocks in paystackmock are
+// generated from the methods of the services and of Client. Run go generate
+// after adding or changing a method.
+
+// Customers returns the customer service as a CustomerAPI
+func (c *Client) Customers() CustomerAPI { return c.Customer }
+
+// Transactions returns the transaction service as a TransactionAPI
+func (c *Client) Transactions() TransactionAPI { return c.Transaction }
+
+// SubAccounts returns the subaccount service as a SubAccountAPI
+func (c *Client) SubAccounts() SubAccountAPI { return c.SubAccount }
+
+// Plans returns the plan service as a PlanAPI
+func (c *Client) Plans() PlanAPI { return c.Plan }
+
+// Subscriptions returns the subscription service as a SubscriptionAPI
+func (c *Client) Subscriptions() SubscriptionAPI { return c.Subscription }
+
+// Pages returns the page service as a PageAPI
+func (c *Client) Pages() PageAPI { return c.Page }
+
+// Settlements returns the settlement service as a SettlementAPI
+func (c *Client) Settlements() SettlementAPI { return c.Settlement }
+
+// Transfers returns the transfer service as a TransferAPI
+func (c *Client) Transfers() TransferAPI { return c.Transfer }
+
+// Charges returns the charge service as a ChargeAPI
+func (c *Client) Charges() ChargeAPI { return c.Charge }
+
+// Banks returns the bank service as a BankAPI
+func (c *Client) Banks() BankAPI { return c.Bank }
+
+// BulkCharges returns the bulk charge service as a BulkChargeAPI
+func (c *Client) BulkCharges() BulkChargeAPI { return c.BulkCharge }
+
+// Refunds returns the refund service as a RefundAPI
+func (c *Client) Refunds() RefundAPI { return c.Refund }
+
+// Disputes returns the dispute service as a DisputeAPI
+func (c *Client) Disputes() DisputeAPI { return c.Dispute }
+
+// DedicatedAccounts returns the dedicated account service as a DedicatedAccountAPI
+func (c *Client) DedicatedAccounts() DedicatedAccountAPI { return c.DedicatedAccount }
+
+// PaymentRequests returns the payment request service as a PaymentRequestAPI
+func (c *Client) PaymentRequests() PaymentRequestAPI { return c.PaymentRequest }
+
+// Products returns the product service as a ProductAPI
+func (c *Client) Products() ProductAPI { return c.Product }
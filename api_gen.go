@@ -0,0 +1,681 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package paystack
+
+import (
+	"context"
+	"time"
+)
+
+// CustomerAPI is implemented by CustomerService
+type CustomerAPI interface {
+	// Create creates a new customer
+	Create(customer *Customer) (*Customer, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, customer *Customer) (*Customer, error)
+	// Update updates a customer's properties.
+	Update(customer *Customer) (*Customer, error)
+	// UpdateWithContext is like Update but carries ctx through to the request
+	UpdateWithContext(ctx context.Context, customer *Customer) (*Customer, error)
+	// Get returns the details of a customer.
+	Get(customerCode string) (*Customer, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, customerCode string) (*Customer, error)
+	// List returns a list of customers.
+	List() (*CustomerList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*CustomerList, error)
+	// ListN returns a list of customers
+	ListN(count int, offset int) (*CustomerList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*CustomerList, error)
+	// ListNWithParams returns a page of customers matching params
+	ListNWithParams(count int, offset int, params *CustomerListParams) (*CustomerList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *CustomerListParams) (*CustomerList, error)
+	// ListAll returns an iterator over all customers, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Customer]
+	// ListAllWithParams returns an iterator over all customers matching params
+	ListAllWithParams(ctx context.Context, params *CustomerListParams, opts *ListOptions) *Iterator[Customer]
+	// SetRiskAction can be used to either whitelist or blacklist a customer
+	SetRiskAction(customerCode string, riskAction string) (*Customer, error)
+	// SetRiskActionWithContext is like SetRiskAction but carries ctx through to the request
+	SetRiskActionWithContext(ctx context.Context, customerCode string, riskAction string) (*Customer, error)
+	// DeactivateAuthorization deactivates an authorization
+	DeactivateAuthorization(authorizationCode string) (*Response, error)
+	// DeactivateAuthorizationWithContext is like DeactivateAuthorization but carries ctx through to the request
+	DeactivateAuthorizationWithContext(ctx context.Context, authorizationCode string) (*Response, error)
+}
+
+// TransactionAPI is implemented by TransactionService
+type TransactionAPI interface {
+	// Initialize initiates a transaction process
+	Initialize(txn *TransactionRequest) (*InitializeResult, error)
+	// InitializeWithContext is like Initialize but carries ctx through to the request
+	InitializeWithContext(ctx context.Context, txn *TransactionRequest) (*InitializeResult, error)
+	// Verify checks that transaction with the given reference exists
+	Verify(reference string) (*Transaction, error)
+	// VerifyWithContext is like Verify but carries ctx through to the request
+	VerifyWithContext(ctx context.Context, reference string) (*Transaction, error)
+	// List returns a list of transactions.
+	List() (*TransactionList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*TransactionList, error)
+	// ListN returns a list of transactions
+	ListN(count int, offset int) (*TransactionList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*TransactionList, error)
+	// ListNWithParams returns a page of transactions matching params
+	ListNWithParams(count int, offset int, params *TransactionListParams) (*TransactionList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *TransactionListParams) (*TransactionList, error)
+	// ListAll returns an iterator over all transactions, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Transaction]
+	// ListAllWithParams returns an iterator over all transactions matching params
+	ListAllWithParams(ctx context.Context, params *TransactionListParams, opts *ListOptions) *Iterator[Transaction]
+	// Get returns the details of a transaction.
+	Get(id int) (*Transaction, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*Transaction, error)
+	// ChargeAuthorization is for charging all authorizations marked as reusable whenever you need to recieve payments.
+	ChargeAuthorization(req *TransactionRequest) (*Transaction, error)
+	// ChargeAuthorizationWithContext is like ChargeAuthorization but carries ctx through to the request
+	ChargeAuthorizationWithContext(ctx context.Context, req *TransactionRequest) (*Transaction, error)
+	// Timeline fetches the transaction timeline.
+	Timeline(reference string) (*TransactionTimeline, error)
+	// TimelineWithContext is like Timeline but carries ctx through to the request
+	TimelineWithContext(ctx context.Context, reference string) (*TransactionTimeline, error)
+	// Totals returns total amount received on your account
+	Totals() (*TransactionTotals, error)
+	// TotalsWithContext is like Totals but carries ctx through to the request
+	TotalsWithContext(ctx context.Context) (*TransactionTotals, error)
+	// Export exports transactions to a downloadable file and returns a link to the file
+	Export(params *ExportParams) (*ExportResult, error)
+	// ExportWithContext is like Export but carries ctx through to the request
+	ExportWithContext(ctx context.Context, params *ExportParams) (*ExportResult, error)
+	// ReAuthorize requests reauthorization
+	ReAuthorize(req AuthorizationRequest) (*ReAuthorizeResult, error)
+	// ReAuthorizeWithContext is like ReAuthorize but carries ctx through to the request
+	ReAuthorizeWithContext(ctx context.Context, req AuthorizationRequest) (*ReAuthorizeResult, error)
+	// CheckAuthorization checks authorization
+	CheckAuthorization(req AuthorizationRequest) (*CheckAuthorizationResult, error)
+	// CheckAuthorizationWithContext is like CheckAuthorization but carries ctx through to the request
+	CheckAuthorizationWithContext(ctx context.Context, req AuthorizationRequest) (*CheckAuthorizationResult, error)
+	// DownloadExport downloads the CSV file at path, as returned by Export, and parses it into transactions.
+	DownloadExport(path string) ([]Transaction, error)
+	// DownloadExportWithContext is like DownloadExport but carries ctx through to the request
+	DownloadExportWithContext(ctx context.Context, path string) ([]Transaction, error)
+}
+
+// SubAccountAPI is implemented by SubAccountService
+type SubAccountAPI interface {
+	// Create creates a new subaccount
+	Create(subaccount *SubAccount) (*SubAccount, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, subaccount *SubAccount) (*SubAccount, error)
+	// Update updates a subaccount's properties.
+	Update(subaccount *SubAccount) (*SubAccount, error)
+	// UpdateWithContext is like Update but carries ctx through to the request
+	UpdateWithContext(ctx context.Context, subaccount *SubAccount) (*SubAccount, error)
+	// Get returns the details of a subaccount.
+	Get(id int) (*SubAccount, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*SubAccount, error)
+	// List returns a list of subaccounts.
+	List() (*SubAccountList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*SubAccountList, error)
+	// ListN returns a list of subaccounts
+	ListN(count int, offset int) (*SubAccountList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*SubAccountList, error)
+	// ListAll returns an iterator over all subaccounts, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[SubAccount]
+}
+
+// PlanAPI is implemented by PlanService
+type PlanAPI interface {
+	// Create creates a new plan
+	Create(plan *Plan) (*Plan, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, plan *Plan) (*Plan, error)
+	// Update updates a plan's properties.
+	Update(plan *Plan) (Response, error)
+	// UpdateWithContext is like Update but carries ctx through to the request
+	UpdateWithContext(ctx context.Context, plan *Plan) (Response, error)
+	// Get returns the details of a plan.
+	Get(id int) (*Plan, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*Plan, error)
+	// List returns a list of plans.
+	List() (*PlanList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*PlanList, error)
+	// ListN returns a list of plans
+	ListN(count int, offset int) (*PlanList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*PlanList, error)
+	// ListAll returns an iterator over all plans, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Plan]
+}
+
+// SubscriptionAPI is implemented by SubscriptionService
+type SubscriptionAPI interface {
+	// Create creates a new subscription
+	Create(subscription *SubscriptionRequest) (*Subscription, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, subscription *SubscriptionRequest) (*Subscription, error)
+	// Update updates a subscription's properties.
+	Update(subscription *Subscription) (*Subscription, error)
+	// UpdateWithContext is like Update but carries ctx through to the request
+	UpdateWithContext(ctx context.Context, subscription *Subscription) (*Subscription, error)
+	// Get returns the details of a subscription.
+	Get(id int) (*Subscription, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*Subscription, error)
+	// List returns a list of subscriptions.
+	List() (*SubscriptionList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*SubscriptionList, error)
+	// ListN returns a list of subscriptions
+	ListN(count int, offset int) (*SubscriptionList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*SubscriptionList, error)
+	// ListNWithParams returns a page of subscriptions matching params
+	ListNWithParams(count int, offset int, params *SubscriptionListParams) (*SubscriptionList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *SubscriptionListParams) (*SubscriptionList, error)
+	// ListAll returns an iterator over all subscriptions, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Subscription]
+	// ListAllWithParams returns an iterator over all subscriptions matching params
+	ListAllWithParams(ctx context.Context, params *SubscriptionListParams, opts *ListOptions) *Iterator[Subscription]
+	// Enable enables a subscription
+	Enable(subscriptionCode string, emailToken string) (Response, error)
+	// EnableWithContext is like Enable but carries ctx through to the request
+	EnableWithContext(ctx context.Context, subscriptionCode string, emailToken string) (Response, error)
+	// Disable disables a subscription
+	Disable(subscriptionCode string, emailToken string) (Response, error)
+	// DisableWithContext is like Disable but carries ctx through to the request
+	DisableWithContext(ctx context.Context, subscriptionCode string, emailToken string) (Response, error)
+}
+
+// PageAPI is implemented by PageService
+type PageAPI interface {
+	// Create creates a new page
+	Create(page *Page) (*Page, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, page *Page) (*Page, error)
+	// Update updates a page's properties.
+	Update(page *Page) (*Page, error)
+	// UpdateWithContext is like Update but carries ctx through to the request
+	UpdateWithContext(ctx context.Context, page *Page) (*Page, error)
+	// AddProducts adds products to a page, by their IDs
+	AddProducts(pageID int, productIDs ...int) (*Page, error)
+	// AddProductsWithContext is like AddProducts but carries ctx through to the request
+	AddProductsWithContext(ctx context.Context, pageID int, productIDs ...int) (*Page, error)
+	// Get returns the details of a page.
+	Get(id int) (*Page, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*Page, error)
+	// List returns a list of pages.
+	List() (*PageList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*PageList, error)
+	// ListN returns a list of pages
+	ListN(count int, offset int) (*PageList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*PageList, error)
+	// ListAll returns an iterator over all pages, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Page]
+}
+
+// SettlementAPI is implemented by SettlementService
+type SettlementAPI interface {
+	// List returns a list of settlements.
+	List() (*SettlementList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*SettlementList, error)
+	// ListN returns a list of settlements
+	ListN(count int, offset int) (*SettlementList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*SettlementList, error)
+	// ListAll returns an iterator over all settlements, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Response]
+}
+
+// TransferAPI is implemented by TransferService
+type TransferAPI interface {
+	// Initiate initiates a new transfer
+	Initiate(req *TransferRequest) (*Transfer, error)
+	// InitiateWithContext is like Initiate but carries ctx through to the request
+	InitiateWithContext(ctx context.Context, req *TransferRequest) (*Transfer, error)
+	// Finalize completes a transfer request
+	Finalize(code string, otp string) (*TransferFinalizeResult, error)
+	// FinalizeWithContext is like Finalize but carries ctx through to the request
+	FinalizeWithContext(ctx context.Context, code string, otp string) (*TransferFinalizeResult, error)
+	// MakeBulkTransfer initiates a new bulk transfer request You need to disable the Transfers OTP requirement to use this endpoint
+	MakeBulkTransfer(req *BulkTransfer) (Response, error)
+	// MakeBulkTransferWithContext is like MakeBulkTransfer but carries ctx through to the request
+	MakeBulkTransferWithContext(ctx context.Context, req *BulkTransfer) (Response, error)
+	// Get returns the details of a transfer.
+	Get(idCode string) (*Transfer, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, idCode string) (*Transfer, error)
+	// List returns a list of transfers.
+	List() (*TransferList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*TransferList, error)
+	// ListN returns a list of transfers
+	ListN(count int, offset int) (*TransferList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*TransferList, error)
+	// ListNWithParams returns a page of transfers matching params
+	ListNWithParams(count int, offset int, params *TransferListParams) (*TransferList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *TransferListParams) (*TransferList, error)
+	// ListAll returns an iterator over all transfers, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Transfer]
+	// ListAllWithParams returns an iterator over all transfers matching params
+	ListAllWithParams(ctx context.Context, params *TransferListParams, opts *ListOptions) *Iterator[Transfer]
+	// ResendOTP generates a new OTP and sends to customer in the event they are having trouble receiving one.
+	ResendOTP(transferCode string, reason string) (Response, error)
+	// ResendOTPWithContext is like ResendOTP but carries ctx through to the request
+	ResendOTPWithContext(ctx context.Context, transferCode string, reason string) (Response, error)
+	// EnableOTP enables OTP requirement for Transfers In the event that a customer wants to stop being able to complete transfers programmatically, this endpoint helps turn OTP requirement back on.
+	EnableOTP() (Response, error)
+	// EnableOTPWithContext is like EnableOTP but carries ctx through to the request
+	EnableOTPWithContext(ctx context.Context) (Response, error)
+	// DisableOTP disables OTP requirement for Transfers In the event that you want to be able to complete transfers programmatically without use of OTPs, this endpoint helps disable that….
+	DisableOTP() (Response, error)
+	// DisableOTPWithContext is like DisableOTP but carries ctx through to the request
+	DisableOTPWithContext(ctx context.Context) (Response, error)
+	// FinalizeOTPDisable finalizes disabling of OTP requirement for Transfers
+	FinalizeOTPDisable(otp string) (Response, error)
+	// FinalizeOTPDisableWithContext is like FinalizeOTPDisable but carries ctx through to the request
+	FinalizeOTPDisableWithContext(ctx context.Context, otp string) (Response, error)
+	// CreateRecipient creates a new transfer recipient
+	CreateRecipient(recipient *TransferRecipient) (*TransferRecipient, error)
+	// CreateRecipientWithContext is like CreateRecipient but carries ctx through to the request
+	CreateRecipientWithContext(ctx context.Context, recipient *TransferRecipient) (*TransferRecipient, error)
+	// ListRecipients returns a list of transfer recipients.
+	ListRecipients() (*TransferRecipientList, error)
+	// ListRecipientsWithContext is like ListRecipients but carries ctx through to the request
+	ListRecipientsWithContext(ctx context.Context) (*TransferRecipientList, error)
+	// ListRecipientsN returns a list of transfer recipients
+	ListRecipientsN(count int, offset int) (*TransferRecipientList, error)
+	// ListRecipientsNWithContext is like ListRecipientsN but carries ctx through to the request
+	ListRecipientsNWithContext(ctx context.Context, count int, offset int) (*TransferRecipientList, error)
+	// ListRecipientsAll returns an iterator over all transfer recipients, fetching pages as needed
+	ListRecipientsAll(ctx context.Context, opts *ListOptions) *Iterator[TransferRecipient]
+}
+
+// ChargeAPI is implemented by ChargeService
+type ChargeAPI interface {
+	// Create submits a charge request using card details or bank details or authorization code A reference is generated if req.Reference is empty and reused on retries.
+	Create(req *ChargeRequest) (*ChargeResult, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, req *ChargeRequest) (*ChargeResult, error)
+	// Tokenize tokenizes payment instrument before a charge
+	Tokenize(req *ChargeRequest) (*TokenizeResult, error)
+	// TokenizeWithContext is like Tokenize but carries ctx through to the request
+	TokenizeWithContext(ctx context.Context, req *ChargeRequest) (*TokenizeResult, error)
+	// SubmitPIN submits PIN to continue a charge
+	SubmitPIN(pin string, reference string) (*ChargeResult, error)
+	// SubmitPINWithContext is like SubmitPIN but carries ctx through to the request
+	SubmitPINWithContext(ctx context.Context, pin string, reference string) (*ChargeResult, error)
+	// SubmitOTP submits OTP to continue a charge
+	SubmitOTP(otp string, reference string) (*ChargeResult, error)
+	// SubmitOTPWithContext is like SubmitOTP but carries ctx through to the request
+	SubmitOTPWithContext(ctx context.Context, otp string, reference string) (*ChargeResult, error)
+	// SubmitPhone submits Phone when requested
+	SubmitPhone(phone string, reference string) (*ChargeResult, error)
+	// SubmitPhoneWithContext is like SubmitPhone but carries ctx through to the request
+	SubmitPhoneWithContext(ctx context.Context, phone string, reference string) (*ChargeResult, error)
+	// SubmitBirthday submits Birthday when requested
+	SubmitBirthday(birthday string, reference string) (*ChargeResult, error)
+	// SubmitBirthdayWithContext is like SubmitBirthday but carries ctx through to the request
+	SubmitBirthdayWithContext(ctx context.Context, birthday string, reference string) (*ChargeResult, error)
+	// CheckPending returns pending charges When you get "pending" as a charge status, wait 30 seconds or more, then make a check to see if its status has changed.
+	CheckPending(reference string) (*ChargeResult, error)
+	// CheckPendingWithContext is like CheckPending but carries ctx through to the request
+	CheckPendingWithContext(ctx context.Context, reference string) (*ChargeResult, error)
+}
+
+// BankAPI is implemented by BankService
+type BankAPI interface {
+	// List returns a list of all the banks.
+	List() (*BankList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*BankList, error)
+	// ResolveBVN docs https://developers.paystack.co/v1.0/reference#resolve-bvn
+	ResolveBVN(bvn int) (*BVNResponse, error)
+	// ResolveBVNWithContext is like ResolveBVN but carries ctx through to the request
+	ResolveBVNWithContext(ctx context.Context, bvn int) (*BVNResponse, error)
+	// ResolveAccountNumber docs https://developers.paystack.co/v1.0/reference#resolve-account-number
+	ResolveAccountNumber(accountNumber string, bankCode string) (*ResolvedAccount, error)
+	// ResolveAccountNumberWithContext is like ResolveAccountNumber but carries ctx through to the request
+	ResolveAccountNumberWithContext(ctx context.Context, accountNumber string, bankCode string) (*ResolvedAccount, error)
+}
+
+// BulkChargeAPI is implemented by BulkChargeService
+type BulkChargeAPI interface {
+	// Initiate initiates a new bulkcharge Items without a reference get a generated one, and the batch is keyed by the combined item references so a retried batch is recognised as the same.
+	Initiate(req *BulkChargeRequest) (*BulkChargeBatch, error)
+	// InitiateWithContext is like Initiate but carries ctx through to the request
+	InitiateWithContext(ctx context.Context, req *BulkChargeRequest) (*BulkChargeBatch, error)
+	// List returns a list of bulkcharges.
+	List() (*BulkChargeBatchList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*BulkChargeBatchList, error)
+	// ListN returns a list of bulkcharges
+	ListN(count int, offset int) (*BulkChargeBatchList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*BulkChargeBatchList, error)
+	// ListAll returns an iterator over all bulk charge batches, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[BulkChargeBatch]
+	// Get returns a bulk charge batch This endpoint retrieves a specific batch code.
+	Get(idCode string) (*BulkChargeBatch, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, idCode string) (*BulkChargeBatch, error)
+	// GetBatchCharges returns charges in a batch This endpoint retrieves the charges associated with a specified batch code.
+	GetBatchCharges(idCode string) (*BatchChargeList, error)
+	// GetBatchChargesWithContext is like GetBatchCharges but carries ctx through to the request
+	GetBatchChargesWithContext(ctx context.Context, idCode string) (*BatchChargeList, error)
+	// PauseBulkCharge stops processing a batch
+	PauseBulkCharge(batchCode string) (Response, error)
+	// PauseBulkChargeWithContext is like PauseBulkCharge but carries ctx through to the request
+	PauseBulkChargeWithContext(ctx context.Context, batchCode string) (Response, error)
+	// ResumeBulkCharge stops processing a batch
+	ResumeBulkCharge(batchCode string) (Response, error)
+	// ResumeBulkChargeWithContext is like ResumeBulkCharge but carries ctx through to the request
+	ResumeBulkChargeWithContext(ctx context.Context, batchCode string) (Response, error)
+}
+
+// RefundAPI is implemented by RefundService
+type RefundAPI interface {
+	// Create refunds a transaction in full, or partially when req.Amount is set
+	Create(req *RefundRequest) (*Refund, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, req *RefundRequest) (*Refund, error)
+	// Get returns the details of a refund.
+	Get(id int) (*Refund, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*Refund, error)
+	// List returns a list of refunds.
+	List() (*RefundList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*RefundList, error)
+	// ListN returns a list of refunds
+	ListN(count int, offset int) (*RefundList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*RefundList, error)
+	// ListNWithParams returns a page of refunds matching params
+	ListNWithParams(count int, offset int, params *RefundListParams) (*RefundList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *RefundListParams) (*RefundList, error)
+	// ListAll returns an iterator over all refunds, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Refund]
+	// ListAllWithParams returns an iterator over all refunds matching params
+	ListAllWithParams(ctx context.Context, params *RefundListParams, opts *ListOptions) *Iterator[Refund]
+}
+
+// DisputeAPI is implemented by DisputeService
+type DisputeAPI interface {
+	// List returns a list of disputes.
+	List() (*DisputeList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*DisputeList, error)
+	// ListN returns a list of disputes
+	ListN(count int, offset int) (*DisputeList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*DisputeList, error)
+	// ListNWithParams returns a page of disputes matching params
+	ListNWithParams(count int, offset int, params *DisputeListParams) (*DisputeList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *DisputeListParams) (*DisputeList, error)
+	// ListAll returns an iterator over all disputes, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Dispute]
+	// ListAllWithParams returns an iterator over all disputes matching params
+	ListAllWithParams(ctx context.Context, params *DisputeListParams, opts *ListOptions) *Iterator[Dispute]
+	// Get returns the details of a dispute.
+	Get(id int) (*Dispute, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*Dispute, error)
+	// ListByTransaction returns the disputes raised against a transaction.
+	ListByTransaction(transactionID int) ([]Dispute, error)
+	// ListByTransactionWithContext is like ListByTransaction but carries ctx through to the request
+	ListByTransactionWithContext(ctx context.Context, transactionID int) ([]Dispute, error)
+	// Update updates the refund amount of a dispute or attaches an uploaded file
+	Update(id int, update *DisputeUpdate) (*Dispute, error)
+	// UpdateWithContext is like Update but carries ctx through to the request
+	UpdateWithContext(ctx context.Context, id int, update *DisputeUpdate) (*Dispute, error)
+	// AddEvidence submits proof that the customer got the goods or service
+	AddEvidence(id int, evidence *DisputeEvidence) (*DisputeEvidence, error)
+	// AddEvidenceWithContext is like AddEvidence but carries ctx through to the request
+	AddEvidenceWithContext(ctx context.Context, id int, evidence *DisputeEvidence) (*DisputeEvidence, error)
+	// UploadURL returns a signed URL to upload a file of proof for a dispute to
+	UploadURL(id int, filename string) (*DisputeUploadURL, error)
+	// UploadURLWithContext is like UploadURL but carries ctx through to the request
+	UploadURLWithContext(ctx context.Context, id int, filename string) (*DisputeUploadURL, error)
+	// Resolve accepts or declines a dispute
+	Resolve(id int, resolution *DisputeResolution) (*Dispute, error)
+	// ResolveWithContext is like Resolve but carries ctx through to the request
+	ResolveWithContext(ctx context.Context, id int, resolution *DisputeResolution) (*Dispute, error)
+	// Export exports disputes to a downloadable file and returns a link to the file
+	Export(params *DisputeListParams) (*ExportResult, error)
+	// ExportWithContext is like Export but carries ctx through to the request
+	ExportWithContext(ctx context.Context, params *DisputeListParams) (*ExportResult, error)
+}
+
+// DedicatedAccountAPI is implemented by DedicatedAccountService
+type DedicatedAccountAPI interface {
+	// Create creates a dedicated account for an existing customer
+	Create(req *DedicatedAccountRequest) (*DedicatedAccount, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, req *DedicatedAccountRequest) (*DedicatedAccount, error)
+	// Assign creates a customer, validates them and assigns them a dedicated account.
+	Assign(req *DedicatedAccountAssignRequest) (*DedicatedAccountAssignResult, error)
+	// AssignWithContext is like Assign but carries ctx through to the request
+	AssignWithContext(ctx context.Context, req *DedicatedAccountAssignRequest) (*DedicatedAccountAssignResult, error)
+	// Get returns the details of a dedicated account.
+	Get(id int) (*DedicatedAccount, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*DedicatedAccount, error)
+	// List returns a list of dedicated accounts.
+	List() (*DedicatedAccountList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*DedicatedAccountList, error)
+	// ListN returns a list of dedicated accounts
+	ListN(count int, offset int) (*DedicatedAccountList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*DedicatedAccountList, error)
+	// ListNWithParams returns a page of dedicated accounts matching params
+	ListNWithParams(count int, offset int, params *DedicatedAccountListParams) (*DedicatedAccountList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *DedicatedAccountListParams) (*DedicatedAccountList, error)
+	// ListAll returns an iterator over all dedicated accounts, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[DedicatedAccount]
+	// ListAllWithParams returns an iterator over all dedicated accounts matching params
+	ListAllWithParams(ctx context.Context, params *DedicatedAccountListParams, opts *ListOptions) *Iterator[DedicatedAccount]
+	// Requery asks Paystack to check the account for transfers it has not yet notified you of.
+	Requery(accountNumber string, providerSlug string, date time.Time) (*DedicatedAccountRequeryResult, error)
+	// RequeryWithContext is like Requery but carries ctx through to the request
+	RequeryWithContext(ctx context.Context, accountNumber string, providerSlug string, date time.Time) (*DedicatedAccountRequeryResult, error)
+	// Deactivate deactivates a dedicated account
+	Deactivate(id int) (*DedicatedAccount, error)
+	// DeactivateWithContext is like Deactivate but carries ctx through to the request
+	DeactivateWithContext(ctx context.Context, id int) (*DedicatedAccount, error)
+	// AddSplit shares the payments into a customer's dedicated account with a subaccount or split, creating the account if the customer has none
+	AddSplit(req *DedicatedAccountRequest) (*DedicatedAccount, error)
+	// AddSplitWithContext is like AddSplit but carries ctx through to the request
+	AddSplitWithContext(ctx context.Context, req *DedicatedAccountRequest) (*DedicatedAccount, error)
+	// RemoveSplit stops sharing the payments into a dedicated account
+	RemoveSplit(accountNumber string) (*DedicatedAccount, error)
+	// RemoveSplitWithContext is like RemoveSplit but carries ctx through to the request
+	RemoveSplitWithContext(ctx context.Context, accountNumber string) (*DedicatedAccount, error)
+	// AvailableProviders returns the banks dedicated accounts can be opened with
+	AvailableProviders() ([]DedicatedAccountProvider, error)
+	// AvailableProvidersWithContext is like AvailableProviders but carries ctx through to the request
+	AvailableProvidersWithContext(ctx context.Context) ([]DedicatedAccountProvider, error)
+}
+
+// PaymentRequestAPI is implemented by PaymentRequestService
+type PaymentRequestAPI interface {
+	// Create creates a payment request and, unless it is a draft, sends it to the customer
+	Create(req *PaymentRequestRequest) (*PaymentRequest, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, req *PaymentRequestRequest) (*PaymentRequest, error)
+	// Update updates a payment request that has not been paid
+	Update(idOrCode string, req *PaymentRequestRequest) (*PaymentRequest, error)
+	// UpdateWithContext is like Update but carries ctx through to the request.
+	UpdateWithContext(ctx context.Context, idOrCode string, req *PaymentRequestRequest) (*PaymentRequest, error)
+	// Get returns the details of a payment request.
+	Get(idOrCode string) (*PaymentRequest, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, idOrCode string) (*PaymentRequest, error)
+	// Verify returns a payment request along with the amount still to be paid
+	Verify(code string) (*PaymentRequest, error)
+	// VerifyWithContext is like Verify but carries ctx through to the request
+	VerifyWithContext(ctx context.Context, code string) (*PaymentRequest, error)
+	// List returns a list of payment requests.
+	List() (*PaymentRequestList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*PaymentRequestList, error)
+	// ListN returns a list of payment requests
+	ListN(count int, offset int) (*PaymentRequestList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*PaymentRequestList, error)
+	// ListNWithParams returns a page of payment requests matching params
+	ListNWithParams(count int, offset int, params *PaymentRequestListParams) (*PaymentRequestList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *PaymentRequestListParams) (*PaymentRequestList, error)
+	// ListAll returns an iterator over all payment requests, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[PaymentRequest]
+	// ListAllWithParams returns an iterator over all payment requests matching params
+	ListAllWithParams(ctx context.Context, params *PaymentRequestListParams, opts *ListOptions) *Iterator[PaymentRequest]
+	// Notify sends the customer an email reminder of a payment request
+	Notify(code string) (Response, error)
+	// NotifyWithContext is like Notify but carries ctx through to the request
+	NotifyWithContext(ctx context.Context, code string) (Response, error)
+	// Totals returns the total amount of payment requests by status
+	Totals() (*PaymentRequestTotals, error)
+	// TotalsWithContext is like Totals but carries ctx through to the request
+	TotalsWithContext(ctx context.Context) (*PaymentRequestTotals, error)
+	// Finalize sends a draft payment request to the customer, by email unless sendNotification is false
+	Finalize(code string, sendNotification bool) (*PaymentRequest, error)
+	// FinalizeWithContext is like Finalize but carries ctx through to the request
+	FinalizeWithContext(ctx context.Context, code string, sendNotification bool) (*PaymentRequest, error)
+	// Archive archives a payment request, so it is no longer listed and cannot be paid
+	Archive(code string) (Response, error)
+	// ArchiveWithContext is like Archive but carries ctx through to the request
+	ArchiveWithContext(ctx context.Context, code string) (Response, error)
+}
+
+// ProductAPI is implemented by ProductService
+type ProductAPI interface {
+	// Create creates a new product
+	Create(req *ProductRequest) (*Product, error)
+	// CreateWithContext is like Create but carries ctx through to the request
+	CreateWithContext(ctx context.Context, req *ProductRequest) (*Product, error)
+	// Update updates the fields of a product that are set in req.
+	Update(id int, req *ProductRequest) (*Product, error)
+	// UpdateWithContext is like Update but carries ctx through to the request.
+	UpdateWithContext(ctx context.Context, id int, req *ProductRequest) (*Product, error)
+	// Get returns the details of a product.
+	Get(id int) (*Product, error)
+	// GetWithContext is like Get but carries ctx through to the request
+	GetWithContext(ctx context.Context, id int) (*Product, error)
+	// List returns a list of products.
+	List() (*ProductList, error)
+	// ListWithContext is like List but carries ctx through to the request
+	ListWithContext(ctx context.Context) (*ProductList, error)
+	// ListN returns a list of products
+	ListN(count int, offset int) (*ProductList, error)
+	// ListNWithContext is like ListN but carries ctx through to the request
+	ListNWithContext(ctx context.Context, count int, offset int) (*ProductList, error)
+	// ListNWithParams returns a page of products matching params
+	ListNWithParams(count int, offset int, params *ProductListParams) (*ProductList, error)
+	// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+	ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *ProductListParams) (*ProductList, error)
+	// ListAll returns an iterator over all products, fetching pages as needed
+	ListAll(ctx context.Context, opts *ListOptions) *Iterator[Product]
+	// ListAllWithParams returns an iterator over all products matching params
+	ListAllWithParams(ctx context.Context, params *ProductListParams, opts *ListOptions) *Iterator[Product]
+}
+
+// API is implemented by Client. It bundles the service interfaces
+// with the calls made on Client directly.
+type API interface {
+	// Customers returns the customer service as a CustomerAPI
+	Customers() CustomerAPI
+	// Transactions returns the transaction service as a TransactionAPI
+	Transactions() TransactionAPI
+	// SubAccounts returns the subaccount service as a SubAccountAPI
+	SubAccounts() SubAccountAPI
+	// Plans returns the plan service as a PlanAPI
+	Plans() PlanAPI
+	// Subscriptions returns the subscription service as a SubscriptionAPI
+	Subscriptions() SubscriptionAPI
+	// Pages returns the page service as a PageAPI
+	Pages() PageAPI
+	// Settlements returns the settlement service as a SettlementAPI
+	Settlements() SettlementAPI
+	// Transfers returns the transfer service as a TransferAPI
+	Transfers() TransferAPI
+	// Charges returns the charge service as a ChargeAPI
+	Charges() ChargeAPI
+	// Banks returns the bank service as a BankAPI
+	Banks() BankAPI
+	// BulkCharges returns the bulk charge service as a BulkChargeAPI
+	BulkCharges() BulkChargeAPI
+	// Refunds returns the refund service as a RefundAPI
+	Refunds() RefundAPI
+	// Disputes returns the dispute service as a DisputeAPI
+	Disputes() DisputeAPI
+	// DedicatedAccounts returns the dedicated account service as a DedicatedAccountAPI
+	DedicatedAccounts() DedicatedAccountAPI
+	// PaymentRequests returns the payment request service as a PaymentRequestAPI
+	PaymentRequests() PaymentRequestAPI
+	// Products returns the product service as a ProductAPI
+	Products() ProductAPI
+	// Call actually does the HTTP request to Paystack API
+	Call(method string, path string, body interface{}, v interface{}) error
+	// CallContext is like Call but binds the HTTP request to ctx, so that cancellation, deadlines and request-scoped values reach the transport
+	CallContext(ctx context.Context, method string, path string, body interface{}, v interface{}) error
+	// ResolveCardBIN docs https://developers.paystack.co/v1.0/reference#resolve-card-bin
+	ResolveCardBIN(bin int) (Response, error)
+	// ResolveCardBINWithContext is like ResolveCardBIN but carries ctx through to the request
+	ResolveCardBINWithContext(ctx context.Context, bin int) (Response, error)
+	// CheckBalance returns the balance of the integration in each of its currencies
+	CheckBalance() ([]Balance, error)
+	// CheckBalanceWithContext is like CheckBalance but carries ctx through to the request
+	CheckBalanceWithContext(ctx context.Context) ([]Balance, error)
+	// GetSessionTimeout fetches payment session timeout
+	GetSessionTimeout() (*SessionTimeout, error)
+	// GetSessionTimeoutWithContext is like GetSessionTimeout but carries ctx through to the request
+	GetSessionTimeoutWithContext(ctx context.Context) (*SessionTimeout, error)
+	// UpdateSessionTimeout updates payment session timeout
+	UpdateSessionTimeout(timeout int) (*SessionTimeout, error)
+	// UpdateSessionTimeoutWithContext is like UpdateSessionTimeout but carries ctx through to the request
+	UpdateSessionTimeoutWithContext(ctx context.Context, timeout int) (*SessionTimeout, error)
+}
+
+var (
+	_ CustomerAPI         = (*CustomerService)(nil)
+	_ TransactionAPI      = (*TransactionService)(nil)
+	_ SubAccountAPI       = (*SubAccountService)(nil)
+	_ PlanAPI             = (*PlanService)(nil)
+	_ SubscriptionAPI     = (*SubscriptionService)(nil)
+	_ PageAPI             = (*PageService)(nil)
+	_ SettlementAPI       = (*SettlementService)(nil)
+	_ TransferAPI         = (*TransferService)(nil)
+	_ ChargeAPI           = (*ChargeService)(nil)
+	_ BankAPI             = (*BankService)(nil)
+	_ BulkChargeAPI       = (*BulkChargeService)(nil)
+	_ RefundAPI           = (*RefundService)(nil)
+	_ DisputeAPI          = (*DisputeService)(nil)
+	_ DedicatedAccountAPI = (*DedicatedAccountService)(nil)
+	_ PaymentRequestAPI   = (*PaymentRequestService)(nil)
+	_ ProductAPI          = (*ProductService)(nil)
+	_ API                 = (*Client)(nil)
+)
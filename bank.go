@@ -1,6 +1,9 @@
 package paystack
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // BankService handles operations related to the bank
 // For more details see https://developers.paystack.co/v1.0/reference#bank
@@ -35,26 +38,51 @@ type BVNResponse struct {
 	BVN string
 }
 
+// ResolvedAccount is the account a number and bank code belong to
+type ResolvedAccount struct {
+	AccountNumber string `json:"account_number,omitempty"`
+	AccountName   string `json:"account_name,omitempty"`
+	BankID        int    `json:"bank_id,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
 // List returns a list of all the banks.
 // For more details see https://developers.paystack.co/v1.0/reference#list-banks
 func (s *BankService) List() (*BankList, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *BankService) ListWithContext(ctx context.Context) (*BankList, error) {
 	banks := &BankList{}
-	err := s.client.Call("GET", "/bank", nil, banks)
+	err := s.client.call(ctx, "Bank.List", "GET", "/bank", nil, banks)
 	return banks, err
 }
 
 // ResolveBVN docs https://developers.paystack.co/v1.0/reference#resolve-bvn
 func (s *BankService) ResolveBVN(bvn int) (*BVNResponse, error) {
+	return s.ResolveBVNWithContext(context.Background(), bvn)
+}
+
+// ResolveBVNWithContext is like ResolveBVN but carries ctx through to the request
+func (s *BankService) ResolveBVNWithContext(ctx context.Context, bvn int) (*BVNResponse, error) {
 	u := fmt.Sprintf("/bank/resolve_bvn/%d", bvn)
 	resp := &BVNResponse{}
-	err := s.client.Call("GET", u, nil, resp)
+	err := s.client.call(ctx, "Bank.ResolveBVN", "GET", u, nil, resp)
 	return resp, err
 }
 
 // ResolveAccountNumber docs https://developers.paystack.co/v1.0/reference#resolve-account-number
-func (s *BankService) ResolveAccountNumber(accountNumber, bankCode string) (Response, error) {
+func (s *BankService) ResolveAccountNumber(accountNumber, bankCode string) (*ResolvedAccount, error) {
+	return s.ResolveAccountNumberWithContext(context.Background(), accountNumber, bankCode)
+}
+
+// ResolveAccountNumberWithContext is like ResolveAccountNumber but carries ctx through to the request
+func (s *BankService) ResolveAccountNumberWithContext(ctx context.Context, accountNumber, bankCode string) (*ResolvedAccount, error) {
 	u := fmt.Sprintf("/bank/resolve?account_number=%s&bank_code=%s", accountNumber, bankCode)
-	resp := Response{}
-	err := s.client.Call("GET", u, nil, &resp)
-	return resp, err
+	account := &ResolvedAccount{}
+	var err error
+	account.Raw, err = s.client.callRaw(ctx, "Bank.ResolveAccountNumber", "GET", u, nil, account)
+	return account, err
 }
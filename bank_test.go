@@ -36,11 +36,11 @@ func TestResolveAccountNumber(t *testing.T) {
 	}
 
 	/*
-		if _, ok := resp["account_number"]; !ok {
+		if resp.AccountNumber == "" {
 			t.Errorf("Expected response to contain 'account_number'")
 		}
 
-		if _, ok := resp["account_name"]; !ok {
+		if resp.AccountName == "" {
 			t.Errorf("Expected response to contain 'account_name'")
 		}
 	*/
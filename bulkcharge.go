@@ -1,6 +1,9 @@
 package paystack
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // BulkChargeService handles operations related to the bulkcharge
 // For more details see https://developers.paystack.co/v1.0/reference#initiate-bulk-charge
@@ -27,8 +30,9 @@ type BulkChargeRequest struct {
 
 // BulkItem represents a single bulk charge request item
 type BulkItem struct {
-	Authorization string  `json:"authorization,omitempty"`
-	Amount        float32 `json:"amount,omitempty"`
+	Authorization string `json:"authorization,omitempty"`
+	Amount        Amount `json:"amount,omitempty"`
+	Reference     string `json:"reference,omitempty"`
 }
 
 // BulkChargeBatchList is a list object for bulkcharges.
@@ -37,38 +41,99 @@ type BulkChargeBatchList struct {
 	Values []BulkChargeBatch `json:"data,omitempty"`
 }
 
+// BatchCharge is a single charge in a bulk charge batch
+type BatchCharge struct {
+	ID            int           `json:"id,omitempty"`
+	CreatedAt     string        `json:"createdAt,omitempty"`
+	UpdatedAt     string        `json:"updatedAt,omitempty"`
+	Integration   int           `json:"integration,omitempty"`
+	Domain        string        `json:"domain,omitempty"`
+	Reference     string        `json:"reference,omitempty"`
+	Amount        Amount        `json:"amount,omitempty"`
+	Currency      Currency      `json:"currency,omitempty"`
+	Status        string        `json:"status,omitempty"`
+	Message       string        `json:"message,omitempty"`
+	Customer      Customer      `json:"customer,omitempty"`
+	Authorization Authorization `json:"authorization,omitempty"`
+}
+
+// BatchChargeList is a list object for the charges in a batch.
+type BatchChargeList struct {
+	Meta   ListMeta
+	Values []BatchCharge `json:"data,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
 // Initiate initiates a new bulkcharge
+// Items without a reference get a generated one, and the batch is keyed by
+// the combined item references so a retried batch is recognised as the same.
 // For more details see https://developers.paystack.co/v1.0/reference#initiate-bulk-charge
 func (s *BulkChargeService) Initiate(req *BulkChargeRequest) (*BulkChargeBatch, error) {
+	return s.InitiateWithContext(context.Background(), req)
+}
+
+// InitiateWithContext is like Initiate but carries ctx through to the request
+func (s *BulkChargeService) InitiateWithContext(ctx context.Context, req *BulkChargeRequest) (*BulkChargeBatch, error) {
+	refs := make([]string, len(req.Items))
+	for i := range req.Items {
+		refs[i] = ensureReference(&req.Items[i].Reference)
+	}
+	if idempotencyKey(ctx) == "" {
+		ctx = WithIdempotencyKey(ctx, batchKey(refs))
+	}
 	bulkcharge := &BulkChargeBatch{}
-	err := s.client.Call("POST", "/bulkcharge", req.Items, bulkcharge)
+	err := s.client.call(ctx, "BulkCharge.Initiate", "POST", "/bulkcharge", req.Items, bulkcharge)
 	return bulkcharge, err
 }
 
 // List returns a list of bulkcharges.
 // For more details see https://developers.paystack.co/v1.0/reference#list-bulkcharges
 func (s *BulkChargeService) List() (*BulkChargeBatchList, error) {
-	return s.ListN(10, 0)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *BulkChargeService) ListWithContext(ctx context.Context) (*BulkChargeBatchList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of bulkcharges
 // For more details see https://developers.paystack.co/v1.0/reference#list-bulkcharges
 func (s *BulkChargeService) ListN(count, offset int) (*BulkChargeBatchList, error) {
-	u := paginateURL("/bulkcharge", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *BulkChargeService) ListNWithContext(ctx context.Context, count, offset int) (*BulkChargeBatchList, error) {
+	u := paginateURL("/bulkcharge", count, offset, nil)
 	bulkcharges := &BulkChargeBatchList{}
-	err := s.client.Call("GET", u, nil, bulkcharges)
+	err := s.client.call(ctx, "BulkCharge.ListN", "GET", u, nil, bulkcharges)
 	return bulkcharges, err
 }
 
+// ListAll returns an iterator over all bulk charge batches, fetching pages as needed
+func (s *BulkChargeService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[BulkChargeBatch] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]BulkChargeBatch, ListMeta, error) {
+		list, err := s.ListNWithContext(ctx, count, page)
+		return list.Values, list.Meta, err
+	})
+}
+
 // Get returns a bulk charge batch
 // This endpoint retrieves a specific batch code.
 // It also returns useful information on its progress by way of
 // the total_charges and pending_charges attributes.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-bulk-charge-batch
 func (s *BulkChargeService) Get(idCode string) (*BulkChargeBatch, error) {
+	return s.GetWithContext(context.Background(), idCode)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *BulkChargeService) GetWithContext(ctx context.Context, idCode string) (*BulkChargeBatch, error) {
 	u := fmt.Sprintf("/bulkcharge/%s", idCode)
 	bulkcharge := &BulkChargeBatch{}
-	err := s.client.Call("GET", u, nil, bulkcharge)
+	err := s.client.call(ctx, "BulkCharge.Get", "GET", u, nil, bulkcharge)
 	return bulkcharge, err
 }
 
@@ -77,19 +142,30 @@ func (s *BulkChargeService) Get(idCode string) (*BulkChargeBatch, error) {
 // Pagination parameters are available. You can also filter by status.
 // Charge statuses can be pending, success or failed.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-charges-in-a-batch
-func (s *BulkChargeService) GetBatchCharges(idCode string) (Response, error) {
+func (s *BulkChargeService) GetBatchCharges(idCode string) (*BatchChargeList, error) {
+	return s.GetBatchChargesWithContext(context.Background(), idCode)
+}
+
+// GetBatchChargesWithContext is like GetBatchCharges but carries ctx through to the request
+func (s *BulkChargeService) GetBatchChargesWithContext(ctx context.Context, idCode string) (*BatchChargeList, error) {
 	u := fmt.Sprintf("/bulkcharge/%s/charges", idCode)
-	resp := Response{}
-	err := s.client.Call("GET", u, nil, &resp)
-	return resp, err
+	charges := &BatchChargeList{}
+	var err error
+	charges.Raw, err = s.client.callRaw(ctx, "BulkCharge.GetBatchCharges", "GET", u, nil, charges)
+	return charges, err
 }
 
 // PauseBulkCharge stops processing a batch
 // For more details see https://developers.paystack.co/v1.0/reference#pause-bulk-charge-batch
 func (s *BulkChargeService) PauseBulkCharge(batchCode string) (Response, error) {
+	return s.PauseBulkChargeWithContext(context.Background(), batchCode)
+}
+
+// PauseBulkChargeWithContext is like PauseBulkCharge but carries ctx through to the request
+func (s *BulkChargeService) PauseBulkChargeWithContext(ctx context.Context, batchCode string) (Response, error) {
 	u := fmt.Sprintf("/bulkcharge/pause/%s", batchCode)
 	resp := Response{}
-	err := s.client.Call("GET", u, nil, &resp)
+	err := s.client.call(ctx, "BulkCharge.PauseBulkCharge", "GET", u, nil, &resp)
 
 	return resp, err
 }
@@ -97,9 +173,14 @@ func (s *BulkChargeService) PauseBulkCharge(batchCode string) (Response, error)
 // ResumeBulkCharge stops processing a batch
 // For more details see https://developers.paystack.co/v1.0/reference#resume-bulk-charge-batch
 func (s *BulkChargeService) ResumeBulkCharge(batchCode string) (Response, error) {
+	return s.ResumeBulkChargeWithContext(context.Background(), batchCode)
+}
+
+// ResumeBulkChargeWithContext is like ResumeBulkCharge but carries ctx through to the request
+func (s *BulkChargeService) ResumeBulkChargeWithContext(ctx context.Context, batchCode string) (Response, error) {
 	u := fmt.Sprintf("/bulkcharge/resume/%s", batchCode)
 	resp := Response{}
-	err := s.client.Call("GET", u, nil, &resp)
+	err := s.client.call(ctx, "BulkCharge.ResumeBulkCharge", "GET", u, nil, &resp)
 
 	return resp, err
 }
@@ -0,0 +1,114 @@
+package cassette
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	hits := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"status":true,"message":"BVN resolved","data":{"bvn":"21212917741","first_name":"JANE"}}`)
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "bvn.json")
+	rec, err := New(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest("GET", ts.URL+"/bank/resolve_bvn/21212917741", nil)
+	req.Header.Set("Authorization", "Bearer sk_live_secret")
+	resp, err := (&http.Client{Transport: rec}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "21212917741") {
+		t.Errorf("Expected the caller to get the unscrubbed response, got %s", body)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := c.Interactions[0]
+	if i.Request.Header.Get("Authorization") != "" || i.Response.Header.Get("Set-Cookie") != "" {
+		t.Error("Expected credentials to be scrubbed")
+	}
+	if strings.Contains(i.Request.URL, "21212917741") || strings.Contains(i.Response.Body, "21212917741") {
+		t.Errorf("Expected BVN to be scrubbed, got %s and %s", i.Request.URL, i.Response.Body)
+	}
+
+	rep, err := New(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: rep}
+	resp, err = client.Get("https://api.paystack.co/bank/resolve_bvn/22222222222")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	if resp.StatusCode != 200 || !strings.Contains(string(body), "JANE") {
+		t.Errorf("Unexpected replayed response %d %s", resp.StatusCode, body)
+	}
+	if hits != 1 {
+		t.Errorf("Expected replay not to reach the server, got %d hits", hits)
+	}
+
+	// each interaction is served once
+	_, err = client.Get("https://api.paystack.co/bank/resolve_bvn/22222222222")
+	if !errors.Is(err, ErrInteractionNotFound) {
+		t.Errorf("Expected interaction not found, got %v", err)
+	}
+}
+
+func TestScrubCardNumbers(t *testing.T) {
+	i := &Interaction{
+		Request: Request{
+			Method: "POST",
+			URL:    "https://api.paystack.co/charge",
+			Header: http.Header{"Authorization": {"Bearer sk_test_x"}},
+			Body:   `{"email":"a@example.com","amount":10000,"card":{"card_number":"4084084084084081","card_cvc":"408"},"pin":"1111"}`,
+		},
+		Response: Response{
+			StatusCode: 200,
+			Body:       `{"status":true,"data":{"reference":"1234567890123","message":"card 4084084084084081 charged"}}`,
+		},
+	}
+	Scrub(i)
+
+	for _, secret := range []string{"4084084084084081", `"408"`, "1111"} {
+		if strings.Contains(i.Request.Body, secret) || strings.Contains(i.Response.Body, secret) {
+			t.Errorf("Expected %s to be scrubbed from %s / %s", secret, i.Request.Body, i.Response.Body)
+		}
+	}
+	if !strings.Contains(i.Request.Body, "408408******4081") {
+		t.Errorf("Expected masked card number to keep BIN and last four, got %s", i.Request.Body)
+	}
+	// digit runs that are not card numbers are kept
+	if !strings.Contains(i.Response.Body, "1234567890123") {
+		t.Errorf("Expected reference to be kept, got %s", i.Response.Body)
+	}
+	if !strings.Contains(i.Request.Body, `"amount":10000`) {
+		t.Errorf("Expected numbers to be preserved, got %s", i.Request.Body)
+	}
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	if _, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil); err == nil {
+		t.Error("Expected error for missing cassette")
+	}
+}
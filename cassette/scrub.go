@@ -0,0 +1,125 @@
+package cassette
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secrets removed from recorded interactions
+const Redacted = "[REDACTED]"
+
+// sensitiveFields are JSON keys whose values are always redacted
+var sensitiveFields = map[string]bool{
+	"bvn":      true,
+	"cvv":      true,
+	"card_cvc": true,
+	"pin":      true,
+}
+
+var (
+	// panLike matches digit runs as long as a card number
+	panLike = regexp.MustCompile(`\b\d{13,19}\b`)
+	bvnPath = regexp.MustCompile(`(/bank/resolve_bvn/)\d+`)
+)
+
+// Scrub removes credentials and card holder data from i in place
+func Scrub(i *Interaction) {
+	i.Request.Header.Del("Authorization")
+	i.Request.Header.Del("Cookie")
+	i.Response.Header.Del("Set-Cookie")
+	i.Request.URL = scrubURL(i.Request.URL)
+	i.Request.Body = scrubBody(i.Request.Body)
+	i.Response.Body = scrubBody(i.Response.Body)
+}
+
+// scrubURL masks BVNs in the path and card numbers anywhere in u
+func scrubURL(u string) string {
+	u = bvnPath.ReplaceAllString(u, "${1}"+Redacted)
+	return panLike.ReplaceAllStringFunc(u, maskPAN)
+}
+
+// requestURI returns the path and query of the absolute or relative URL u
+func requestURI(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return u
+	}
+	return parsed.RequestURI()
+}
+
+// scrubBody redacts sensitive fields of a JSON body and masks card numbers.
+// Bodies that are not JSON only have card numbers masked.
+func scrubBody(body string) string {
+	if body == "" {
+		return body
+	}
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return panLike.ReplaceAllStringFunc(body, maskPAN)
+	}
+	b, err := json.Marshal(scrubValue(v))
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+func scrubValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, item := range t {
+			switch name := strings.ToLower(k); {
+			case sensitiveFields[name]:
+				t[k] = Redacted
+				continue
+			case name == "card_number":
+				if s, ok := item.(string); ok && len(s) > 10 {
+					t[k] = mask(s)
+					continue
+				}
+			}
+			t[k] = scrubValue(item)
+		}
+	case []interface{}:
+		for n, item := range t {
+			t[n] = scrubValue(item)
+		}
+	case string:
+		return panLike.ReplaceAllStringFunc(t, maskPAN)
+	}
+	return v
+}
+
+// maskPAN keeps the BIN and last four digits of a Luhn-valid card number,
+// which is what Paystack itself returns, and leaves other digit runs alone
+func maskPAN(s string) string {
+	if !luhn(s) {
+		return s
+	}
+	return mask(s)
+}
+
+func mask(s string) string {
+	return s[:6] + strings.Repeat("*", len(s)-10) + s[len(s)-4:]
+}
+
+func luhn(s string) bool {
+	sum := 0
+	double := false
+	for n := len(s) - 1; n >= 0; n-- {
+		d := int(s[n] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
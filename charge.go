@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -32,82 +33,168 @@ type BankAccount struct {
 // ChargeRequest represents a Paystack charge request
 type ChargeRequest struct {
 	Email             string       `json:"email,omitempty"`
-	Amount            float32      `json:"amount,omitempty"`
+	Amount            Amount       `json:"amount,omitempty"`
+	Currency          Currency     `json:"currency,omitempty"`
 	Birthday          string       `json:"birthday,omitempty"`
 	Card              *Card        `json:"card,omitempty"`
 	Bank              *BankAccount `json:"bank,omitempty"`
 	AuthorizationCode string       `json:"authorization_code,omitempty"`
+	Reference         string       `json:"reference,omitempty"`
 	Pin               string       `json:"pin,omitempty"`
 	Metadata          *Metadata    `json:"metadata,omitempty"`
 }
 
+// ChargeResult is the state of a charge. Status tells what to do next:
+// "send_pin", "send_otp", "send_phone" or "send_birthday" ask for the matching
+// Submit call, "open_url" for the customer to visit URL, "pending" for a later
+// CheckPending, and "success" or "failed" are final.
+type ChargeResult struct {
+	Status    string `json:"status,omitempty"`
+	Reference string `json:"reference,omitempty"`
+	// DisplayText is the prompt to show the customer, if any
+	DisplayText     string        `json:"display_text,omitempty"`
+	URL             string        `json:"url,omitempty"`
+	Message         string        `json:"message,omitempty"`
+	GatewayResponse string        `json:"gateway_response,omitempty"`
+	Amount          Amount        `json:"amount,omitempty"`
+	Currency        Currency      `json:"currency,omitempty"`
+	Authorization   Authorization `json:"authorization,omitempty"`
+	Customer        Customer      `json:"customer,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// TokenizeResult is a tokenized payment instrument
+type TokenizeResult struct {
+	Authorization Authorization
+	Customer      Customer
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
 // Create submits a charge request using card details or bank details or authorization code
+// A reference is generated if req.Reference is empty and reused on retries.
 // For more details see https://developers.paystack.co/v1.0/reference#charge
-func (s *ChargeService) Create(req *ChargeRequest) (Response, error) {
-	resp := Response{}
-	err := s.client.Call("POST", "/charge", req, &resp)
-	return resp, err
+func (s *ChargeService) Create(req *ChargeRequest) (*ChargeResult, error) {
+	return s.CreateWithContext(context.Background(), req)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *ChargeService) CreateWithContext(ctx context.Context, req *ChargeRequest) (*ChargeResult, error) {
+	if req == nil {
+		return nil, newRequestValidationError("request", "charge request is required")
+	}
+	ctx = withReference(ctx, &req.Reference)
+	body := *req
+	body.Currency = s.client.currency(body.Currency)
+	if err := checkCurrency(body.Currency, body.Amount, CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
+	result := &ChargeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Charge.Create", "POST", "/charge", &body, result)
+	return result, err
 }
 
 // Tokenize tokenizes payment instrument before a charge
 // For more details see https://developers.paystack.co/v1.0/reference#charge-tokenize
-func (s *ChargeService) Tokenize(req *ChargeRequest) (Response, error) {
-	resp := Response{}
-	err := s.client.Call("POST", "/charge/tokenize", req, &resp)
-	return resp, err
+func (s *ChargeService) Tokenize(req *ChargeRequest) (*TokenizeResult, error) {
+	return s.TokenizeWithContext(context.Background(), req)
+}
+
+// TokenizeWithContext is like Tokenize but carries ctx through to the request
+func (s *ChargeService) TokenizeWithContext(ctx context.Context, req *ChargeRequest) (*TokenizeResult, error) {
+	result := &TokenizeResult{}
+	raw, err := s.client.callRaw(ctx, "Charge.Tokenize", "POST", "/charge/tokenize", req, &result.Authorization)
+	if err != nil {
+		return result, err
+	}
+	result.Raw = raw
+	err = mapstruct(raw["customer"], &result.Customer)
+	return result, err
 }
 
 // SubmitPIN submits PIN to continue a charge
 // For more details see https://developers.paystack.co/v1.0/reference#submit-pin
-func (s *ChargeService) SubmitPIN(pin, reference string) (Response, error) {
+func (s *ChargeService) SubmitPIN(pin, reference string) (*ChargeResult, error) {
+	return s.SubmitPINWithContext(context.Background(), pin, reference)
+}
+
+// SubmitPINWithContext is like SubmitPIN but carries ctx through to the request
+func (s *ChargeService) SubmitPINWithContext(ctx context.Context, pin, reference string) (*ChargeResult, error) {
 	data := url.Values{}
 	data.Add("pin", pin)
 	data.Add("reference", reference)
-	resp := Response{}
-	err := s.client.Call("POST", "/charge/submit_pin", data, &resp)
-	return resp, err
+	result := &ChargeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Charge.SubmitPIN", "POST", "/charge/submit_pin", data, result)
+	return result, err
 }
 
 // SubmitOTP submits OTP to continue a charge
 // For more details see https://developers.paystack.co/v1.0/reference#submit-pin
-func (s *ChargeService) SubmitOTP(otp, reference string) (Response, error) {
+func (s *ChargeService) SubmitOTP(otp, reference string) (*ChargeResult, error) {
+	return s.SubmitOTPWithContext(context.Background(), otp, reference)
+}
+
+// SubmitOTPWithContext is like SubmitOTP but carries ctx through to the request
+func (s *ChargeService) SubmitOTPWithContext(ctx context.Context, otp, reference string) (*ChargeResult, error) {
 	data := url.Values{}
-	data.Add("pin", otp)
+	data.Add("otp", otp)
 	data.Add("reference", reference)
-	resp := Response{}
-	err := s.client.Call("POST", "/charge/submit_otp", data, &resp)
-	return resp, err
+	result := &ChargeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Charge.SubmitOTP", "POST", "/charge/submit_otp", data, result)
+	return result, err
 }
 
 // SubmitPhone submits Phone when requested
 // For more details see https://developers.paystack.co/v1.0/reference#submit-pin
-func (s *ChargeService) SubmitPhone(phone, reference string) (Response, error) {
+func (s *ChargeService) SubmitPhone(phone, reference string) (*ChargeResult, error) {
+	return s.SubmitPhoneWithContext(context.Background(), phone, reference)
+}
+
+// SubmitPhoneWithContext is like SubmitPhone but carries ctx through to the request
+func (s *ChargeService) SubmitPhoneWithContext(ctx context.Context, phone, reference string) (*ChargeResult, error) {
 	data := url.Values{}
-	data.Add("pin", phone)
+	data.Add("phone", phone)
 	data.Add("reference", reference)
-	resp := Response{}
-	err := s.client.Call("POST", "/charge/submit_phone", data, &resp)
-	return resp, err
+	result := &ChargeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Charge.SubmitPhone", "POST", "/charge/submit_phone", data, result)
+	return result, err
 }
 
 // SubmitBirthday submits Birthday when requested
 // For more details see https://developers.paystack.co/v1.0/reference#submit-pin
-func (s *ChargeService) SubmitBirthday(birthday, reference string) (Response, error) {
+func (s *ChargeService) SubmitBirthday(birthday, reference string) (*ChargeResult, error) {
+	return s.SubmitBirthdayWithContext(context.Background(), birthday, reference)
+}
+
+// SubmitBirthdayWithContext is like SubmitBirthday but carries ctx through to the request
+func (s *ChargeService) SubmitBirthdayWithContext(ctx context.Context, birthday, reference string) (*ChargeResult, error) {
 	data := url.Values{}
-	data.Add("pin", birthday)
+	data.Add("birthday", birthday)
 	data.Add("reference", reference)
-	resp := Response{}
-	err := s.client.Call("POST", "/charge/submit_birthday", data, &resp)
-	return resp, err
+	result := &ChargeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Charge.SubmitBirthday", "POST", "/charge/submit_birthday", data, result)
+	return result, err
 }
 
 // CheckPending returns pending charges
 // When you get "pending" as a charge status, wait 30 seconds or more,
 // then make a check to see if its status has changed. Don't call too early as you may get a lot more pending than you should.
 // For more details see https://developers.paystack.co/v1.0/reference#check-pending-charge
-func (s *ChargeService) CheckPending(reference string) (Response, error) {
+func (s *ChargeService) CheckPending(reference string) (*ChargeResult, error) {
+	return s.CheckPendingWithContext(context.Background(), reference)
+}
+
+// CheckPendingWithContext is like CheckPending but carries ctx through to the request
+func (s *ChargeService) CheckPendingWithContext(ctx context.Context, reference string) (*ChargeResult, error) {
 	u := fmt.Sprintf("/charge/%s", reference)
-	resp := Response{}
-	err := s.client.Call("GET", u, nil, &resp)
-	return resp, err
+	result := &ChargeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Charge.CheckPending", "GET", u, nil, result)
+	return result, err
 }
@@ -1,6 +1,9 @@
 package paystack
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -22,7 +25,7 @@ func TestChargeServiceCreate(t *testing.T) {
 		t.Errorf("Create Charge returned error: %v", err)
 	}
 
-	if resp["reference"] == "" {
+	if resp.Reference == "" {
 		t.Error("Missing transaction reference")
 	}
 }
@@ -45,20 +48,46 @@ func TestChargeServiceCheckPending(t *testing.T) {
 		t.Errorf("Create charge returned error: %v", err)
 	}
 
-	if resp["reference"] == "" {
+	if resp.Reference == "" {
 		t.Error("Missing charge reference")
 	}
 
-	resp2, err := c.Charge.CheckPending(resp["reference"].(string))
+	resp2, err := c.Charge.CheckPending(resp.Reference)
 	if err != nil {
 		t.Errorf("Check pending charge returned error: %v", err)
 	}
 
-	if resp2["status"] == "" {
+	if resp2.Status == "" {
 		t.Error("Missing charge pending status")
 	}
 
-	if resp2["reference"] == "" {
+	if resp2.Reference == "" {
 		t.Error("Missing charge pending reference")
 	}
 }
+
+func TestChargeServiceSubmitFields(t *testing.T) {
+	var sent map[string][]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = nil
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{"status":true,"message":"Charge attempted","data":{"status":"success","reference":"ref"}}`))
+	}))
+	defer ts.Close()
+	client := newTestClient(ts.URL)
+
+	submits := map[string]func() error{
+		"pin":      func() error { _, err := client.Charge.SubmitPIN("value", "ref"); return err },
+		"otp":      func() error { _, err := client.Charge.SubmitOTP("value", "ref"); return err },
+		"phone":    func() error { _, err := client.Charge.SubmitPhone("value", "ref"); return err },
+		"birthday": func() error { _, err := client.Charge.SubmitBirthday("value", "ref"); return err },
+	}
+	for field, submit := range submits {
+		if err := submit(); err != nil {
+			t.Fatal(err)
+		}
+		if len(sent[field]) != 1 || sent[field][0] != "value" || len(sent) != 2 {
+			t.Errorf("Expected %s to be sent as %q, got %v", field, field, sent)
+		}
+	}
+}
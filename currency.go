@@ -0,0 +1,158 @@
+package paystack
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Currency is the ISO 4217 code of a currency supported by Paystack
+type Currency string
+
+// Currencies supported by Paystack
+const (
+	NGN Currency = "NGN" // Nigerian naira
+	GHS Currency = "GHS" // Ghanaian cedi
+	ZAR Currency = "ZAR" // South African rand
+	KES Currency = "KES" // Kenyan shilling
+	USD Currency = "USD" // US dollar
+)
+
+// CurrencyInfo describes a currency and the limits Paystack applies to it.
+// All limits are in minor units; a zero maximum means no limit.
+type CurrencyInfo struct {
+	Code   Currency
+	Name   string
+	Symbol string
+	// Exponent is the number of decimal places of the minor unit
+	Exponent int
+
+	MinCharge Amount
+	// MaxCharge is left at 0 in the built-in entries, as the maximum
+	// depends on the account; set it with RegisterCurrency to check it
+	// before a request is sent
+	MaxCharge Amount
+
+	// Transfers reports whether payouts can be made in the currency
+	Transfers   bool
+	MinTransfer Amount
+	MaxTransfer Amount
+}
+
+var (
+	currenciesMu sync.RWMutex
+
+	// currencies holds the registered currencies. The limits are Paystack's
+	// published defaults; integrations with different limits can override
+	// them with RegisterCurrency.
+	currencies = map[Currency]CurrencyInfo{
+		NGN: {Code: NGN, Name: "Nigerian Naira", Symbol: "₦", Exponent: 2,
+			MinCharge: 5000, Transfers: true, MinTransfer: 10000, MaxTransfer: 1000000000},
+		GHS: {Code: GHS, Name: "Ghanaian Cedi", Symbol: "GH₵", Exponent: 2,
+			MinCharge: 10, Transfers: true, MinTransfer: 100},
+		ZAR: {Code: ZAR, Name: "South African Rand", Symbol: "R", Exponent: 2,
+			MinCharge: 100, Transfers: true, MinTransfer: 100},
+		KES: {Code: KES, Name: "Kenyan Shilling", Symbol: "KSh", Exponent: 2,
+			MinCharge: 300, Transfers: true, MinTransfer: 1000},
+		USD: {Code: USD, Name: "US Dollar", Symbol: "$", Exponent: 2,
+			MinCharge: 200},
+	}
+)
+
+// RegisterCurrency adds a currency to the registry or replaces its entry
+func RegisterCurrency(info CurrencyInfo) {
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	currencies[info.Code] = info
+}
+
+// LookupCurrency returns the registry entry for code
+func LookupCurrency(code Currency) (CurrencyInfo, bool) {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+	info, ok := currencies[code]
+	return info, ok
+}
+
+// Currencies returns the codes of all registered currencies, sorted
+func Currencies() []Currency {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+	codes := make([]Currency, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+	return codes
+}
+
+// Supported reports whether the currency is registered
+func (c Currency) Supported() bool {
+	_, ok := LookupCurrency(c)
+	return ok
+}
+
+// Format renders an amount in minor units for display using the currency
+// symbol, e.g. "₦1,250.50". Unknown currencies are prefixed with their code.
+func (c Currency) Format(a Amount) string {
+	info, ok := LookupCurrency(c)
+	if !ok {
+		return string(c) + " " + formatMinor(int64(a), minorUnitExponent)
+	}
+	s := formatMinor(int64(a), info.Exponent)
+	if a < 0 {
+		return "-" + info.Symbol + s[1:]
+	}
+	return info.Symbol + s
+}
+
+// exponent returns the currency's minor unit exponent, defaulting to 2
+func (c Currency) exponent() int {
+	if info, ok := LookupCurrency(c); ok {
+		return info.Exponent
+	}
+	return minorUnitExponent
+}
+
+// ValidateCharge checks amount against the currency's charge limits
+func (info CurrencyInfo) ValidateCharge(amount Amount) error {
+	return info.validate("charge", amount, info.MinCharge, info.MaxCharge)
+}
+
+// ValidateTransfer checks amount against the currency's transfer limits
+func (info CurrencyInfo) ValidateTransfer(amount Amount) error {
+	if !info.Transfers {
+		return fmt.Errorf("transfers are not supported in %s", info.Code)
+	}
+	return info.validate("transfer", amount, info.MinTransfer, info.MaxTransfer)
+}
+
+func (info CurrencyInfo) validate(kind string, amount, min, max Amount) error {
+	if amount < min {
+		return fmt.Errorf("minimum %s is %s", kind, info.Code.Format(min))
+	}
+	if max > 0 && amount > max {
+		return fmt.Errorf("maximum %s is %s", kind, info.Code.Format(max))
+	}
+	return nil
+}
+
+// checkCurrency validates a request's currency and, if non-zero, its amount
+// before it is sent. An empty currency is left for Paystack to default.
+// The limit check is skipped when check is nil.
+func checkCurrency(cur Currency, amount Amount, check func(CurrencyInfo, Amount) error) error {
+	if cur == "" {
+		return nil
+	}
+	info, ok := LookupCurrency(cur)
+	if !ok {
+		return newRequestValidationError("currency", fmt.Sprintf("unsupported currency %q", cur))
+	}
+	if check == nil || amount == 0 {
+		return nil
+	}
+	if err := check(info, amount); err != nil {
+		return newRequestValidationError("amount", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCurrencyRegistry(t *testing.T) {
+	for _, code := range []Currency{NGN, GHS, ZAR, KES, USD} {
+		info, ok := LookupCurrency(code)
+		if !ok || info.Exponent != 2 || info.MinCharge <= 0 || info.MaxCharge != 0 {
+			t.Errorf("Unexpected registry entry for %s: %+v", code, info)
+		}
+	}
+	if Currency("XYZ").Supported() {
+		t.Error("Expected XYZ to be unsupported")
+	}
+
+	RegisterCurrency(CurrencyInfo{Code: "XOF", Name: "West African CFA franc", Symbol: "CFA ", Exponent: 0, MinCharge: 100, MaxCharge: 5000000})
+	defer func() {
+		currenciesMu.Lock()
+		delete(currencies, "XOF")
+		currenciesMu.Unlock()
+	}()
+
+	if got := Currency("XOF").Format(2500); got != "CFA 2,500" {
+		t.Errorf("Unexpected XOF format %q", got)
+	}
+	if m, _ := ParseMoney("2500", "XOF"); m.Amount != 2500 || m.String() != "XOF 2,500" {
+		t.Errorf("Unexpected XOF money %+v", m)
+	}
+	xof, _ := LookupCurrency("XOF")
+	if err := xof.ValidateCharge(5000001); err == nil || err.Error() != "maximum charge is CFA 5,000,000" {
+		t.Errorf("Expected charge over the configured maximum to be rejected, got %v", err)
+	}
+}
+
+func TestCurrencyFormat(t *testing.T) {
+	cases := map[string]string{
+		NGN.Format(125050):        "₦1,250.50",
+		GHS.Format(-199):          "-GH₵1.99",
+		Currency("EUR").Format(5): "EUR 0.05",
+	}
+	for got, want := range cases {
+		if got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+}
+
+func TestCurrencyValidationBeforeCall(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte(`{"status":true,"message":"ok","data":{}}`))
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+
+	_, err := client.Transaction.Initialize(&TransactionRequest{Email: "a@b.co", Amount: 100, Currency: NGN})
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Fields["amount"] == nil || !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected amount validation error, got %v", err)
+	}
+
+	_, err = client.Charge.Create(&ChargeRequest{Email: "a@b.co", Amount: 100, Currency: NGN, AuthorizationCode: "AUTH_1"})
+	if !errors.As(err, &verr) || verr.Fields["amount"] == nil {
+		t.Errorf("Expected charge amount validation error, got %v", err)
+	}
+
+	_, err = client.Transfer.Initiate(&TransferRequest{Amount: 50000, Currency: USD, Recipient: "RCP_1"})
+	if !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected USD transfer to be rejected, got %v", err)
+	}
+
+	_, err = client.Plan.Create(&Plan{Name: "Gold", Amount: 500000, Currency: "XYZ"})
+	if !errors.As(err, &verr) || verr.Fields["currency"] == nil {
+		t.Errorf("Expected currency validation error, got %v", err)
+	}
+
+	if n := atomic.LoadInt32(&hits); n != 0 {
+		t.Errorf("Expected no request to reach the server, got %d", n)
+	}
+
+	if _, err := client.Transaction.Initialize(&TransactionRequest{Email: "a@b.co", Amount: 5000, Currency: NGN}); err != nil {
+		t.Errorf("Expected valid request to be sent, got %v", err)
+	}
+}
@@ -1,8 +1,10 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"time"
 )
 
 // CustomerService handles operations related to the customer
@@ -34,12 +36,33 @@ type CustomerList struct {
 	Values []Customer `json:"data"`
 }
 
+// CustomerListParams filters the customers returned by ListNWithParams and ListAllWithParams
+type CustomerListParams struct {
+	From time.Time
+	To   time.Time
+}
+
+func (p *CustomerListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	setTime(q, "from", p.From)
+	setTime(q, "to", p.To)
+	return q
+}
+
 // Create creates a new customer
 // For more details see https://developers.paystack.co/v1.0/reference#create-customer
 func (s *CustomerService) Create(customer *Customer) (*Customer, error) {
+	return s.CreateWithContext(context.Background(), customer)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *CustomerService) CreateWithContext(ctx context.Context, customer *Customer) (*Customer, error) {
 	u := fmt.Sprintf("/customer")
 	cust := &Customer{}
-	err := s.client.Call("POST", u, customer, cust)
+	err := s.client.call(ctx, "Customer.Create", "POST", u, customer, cust)
 
 	return cust, err
 }
@@ -47,9 +70,14 @@ func (s *CustomerService) Create(customer *Customer) (*Customer, error) {
 // Update updates a customer's properties.
 // For more details see https://developers.paystack.co/v1.0/reference#update-customer
 func (s *CustomerService) Update(customer *Customer) (*Customer, error) {
+	return s.UpdateWithContext(context.Background(), customer)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the request
+func (s *CustomerService) UpdateWithContext(ctx context.Context, customer *Customer) (*Customer, error) {
 	u := fmt.Sprintf("customer/%d", customer.ID)
 	cust := &Customer{}
-	err := s.client.Call("PUT", u, customer, cust)
+	err := s.client.call(ctx, "Customer.Update", "PUT", u, customer, cust)
 
 	return cust, err
 }
@@ -57,9 +85,14 @@ func (s *CustomerService) Update(customer *Customer) (*Customer, error) {
 // Get returns the details of a customer.
 // For more details see https://paystack.com/docs/api/#customer-fetch
 func (s *CustomerService) Get(customerCode string) (*Customer, error) {
+	return s.GetWithContext(context.Background(), customerCode)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *CustomerService) GetWithContext(ctx context.Context, customerCode string) (*Customer, error) {
 	u := fmt.Sprintf("/customer/%s", customerCode)
 	cust := &Customer{}
-	err := s.client.Call("GET", u, nil, cust)
+	err := s.client.call(ctx, "Customer.Get", "GET", u, nil, cust)
 
 	return cust, err
 }
@@ -67,21 +100,59 @@ func (s *CustomerService) Get(customerCode string) (*Customer, error) {
 // List returns a list of customers.
 // For more details see https://developers.paystack.co/v1.0/reference#list-customers
 func (s *CustomerService) List() (*CustomerList, error) {
-	return s.ListN(10, 0)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *CustomerService) ListWithContext(ctx context.Context) (*CustomerList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of customers
 // For more details see https://developers.paystack.co/v1.0/reference#list-customers
 func (s *CustomerService) ListN(count, offset int) (*CustomerList, error) {
-	u := paginateURL("/customer", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *CustomerService) ListNWithContext(ctx context.Context, count, offset int) (*CustomerList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of customers matching params
+func (s *CustomerService) ListNWithParams(count, offset int, params *CustomerListParams) (*CustomerList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *CustomerService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *CustomerListParams) (*CustomerList, error) {
+	u := paginateURL("/customer", count, offset, params.values())
 	cust := &CustomerList{}
-	err := s.client.Call("GET", u, nil, cust)
+	err := s.client.call(ctx, "Customer.ListN", "GET", u, nil, cust)
 	return cust, err
 }
 
+// ListAll returns an iterator over all customers, fetching pages as needed
+func (s *CustomerService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Customer] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all customers matching params
+func (s *CustomerService) ListAllWithParams(ctx context.Context, params *CustomerListParams, opts *ListOptions) *Iterator[Customer] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Customer, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
+
 // SetRiskAction can be used to either whitelist or blacklist a customer
 // For more details see https://developers.paystack.co/v1.0/reference#whiteblacklist-customer
 func (s *CustomerService) SetRiskAction(customerCode, riskAction string) (*Customer, error) {
+	return s.SetRiskActionWithContext(context.Background(), customerCode, riskAction)
+}
+
+// SetRiskActionWithContext is like SetRiskAction but carries ctx through to the request
+func (s *CustomerService) SetRiskActionWithContext(ctx context.Context, customerCode, riskAction string) (*Customer, error) {
 	reqBody := struct {
 		Customer    string `json:"customer"`
 		Risk_action string `json:"risk_action"`
@@ -90,7 +161,7 @@ func (s *CustomerService) SetRiskAction(customerCode, riskAction string) (*Custo
 		Risk_action: riskAction,
 	}
 	cust := &Customer{}
-	err := s.client.Call("POST", "/customer/set_risk_action", reqBody, cust)
+	err := s.client.call(ctx, "Customer.SetRiskAction", "POST", "/customer/set_risk_action", reqBody, cust)
 
 	return cust, err
 }
@@ -98,11 +169,16 @@ func (s *CustomerService) SetRiskAction(customerCode, riskAction string) (*Custo
 // DeactivateAuthorization deactivates an authorization
 // For more details see https://developers.paystack.co/v1.0/reference#deactivate-authorization
 func (s *CustomerService) DeactivateAuthorization(authorizationCode string) (*Response, error) {
+	return s.DeactivateAuthorizationWithContext(context.Background(), authorizationCode)
+}
+
+// DeactivateAuthorizationWithContext is like DeactivateAuthorization but carries ctx through to the request
+func (s *CustomerService) DeactivateAuthorizationWithContext(ctx context.Context, authorizationCode string) (*Response, error) {
 	params := url.Values{}
 	params.Add("authorization_code", authorizationCode)
 
 	resp := &Response{}
-	err := s.client.Call("POST", "/customer/deactivate_authorization", params, resp)
+	err := s.client.call(ctx, "Customer.DeactivateAuthorization", "POST", "/customer/deactivate_authorization", params, resp)
 
 	return resp, err
 }
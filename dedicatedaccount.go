@@ -0,0 +1,331 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DedicatedAccountService handles operations related to dedicated virtual
+// accounts, the bank accounts (NUBANs) customers pay into by transfer
+// For more details see https://developers.paystack.co/v1.0/reference#create-dedicated-account
+type DedicatedAccountService service
+
+// DedicatedAccount represents a bank account set aside for one customer
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-dedicated-account
+type DedicatedAccount struct {
+	ID            int                         `json:"id,omitempty"`
+	CreatedAt     string                      `json:"created_at,omitempty"`
+	UpdatedAt     string                      `json:"updated_at,omitempty"`
+	AccountName   string                      `json:"account_name,omitempty"`
+	AccountNumber string                      `json:"account_number,omitempty"`
+	Assigned      bool                        `json:"assigned,omitempty"`
+	Active        bool                        `json:"active,omitempty"`
+	Currency      Currency                    `json:"currency,omitempty"`
+	Metadata      Metadata                    `json:"metadata,omitempty"`
+	Bank          Bank                        `json:"bank,omitempty"`
+	Customer      Customer                    `json:"customer,omitempty"`
+	Assignment    *DedicatedAccountAssignment `json:"assignment,omitempty"`
+	// SplitConfig is the split payments into the account are shared by, if any
+	SplitConfig interface{} `json:"split_config,omitempty"`
+}
+
+// DedicatedAccountAssignment describes who a dedicated account is assigned to
+type DedicatedAccountAssignment struct {
+	Integration  int    `json:"integration,omitempty"`
+	AssigneeID   int    `json:"assignee_id,omitempty"`
+	AssigneeType string `json:"assignee_type,omitempty"`
+	Expired      bool   `json:"expired,omitempty"`
+	AccountType  string `json:"account_type,omitempty"`
+	AssignedAt   string `json:"assigned_at,omitempty"`
+}
+
+// DedicatedAccountRequest represents a request to create a dedicated account
+// for an existing customer, or to split payments into one
+type DedicatedAccountRequest struct {
+	// Customer is the ID or code of the customer
+	Customer string `json:"customer"`
+	// PreferredBank is the provider slug of the bank, see AvailableProviders
+	PreferredBank string `json:"preferred_bank,omitempty"`
+	Subaccount    string `json:"subaccount,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+}
+
+// DedicatedAccountAssignRequest represents a request to create a customer,
+// validate them and assign them a dedicated account in one step
+type DedicatedAccountAssignRequest struct {
+	Email         string `json:"email"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	Phone         string `json:"phone"`
+	PreferredBank string `json:"preferred_bank"`
+	// Country is the two letter country code of the customer, e.g. NG
+	Country       string `json:"country"`
+	AccountNumber string `json:"account_number,omitempty"`
+	BVN           string `json:"bvn,omitempty"`
+	BankCode      string `json:"bank_code,omitempty"`
+	Subaccount    string `json:"subaccount,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+}
+
+// DedicatedAccountAssignResult acknowledges an Assign request. The account
+// itself arrives with the dedicatedaccount.assign.success webhook.
+type DedicatedAccountAssignResult struct {
+	Status  bool   `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// DedicatedAccountRequeryResult acknowledges a Requery request. Transfers
+// found by the requery are reported by webhook.
+type DedicatedAccountRequeryResult struct {
+	Status  bool   `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// DedicatedAccountProvider is a bank dedicated accounts can be opened with
+type DedicatedAccountProvider struct {
+	ID           int    `json:"id,omitempty"`
+	ProviderSlug string `json:"provider_slug,omitempty"`
+	BankID       int    `json:"bank_id,omitempty"`
+	BankName     string `json:"bank_name,omitempty"`
+}
+
+// DedicatedAccountList is a list object for dedicated accounts.
+type DedicatedAccountList struct {
+	Meta   ListMeta
+	Values []DedicatedAccount `json:"data,omitempty"`
+}
+
+// DedicatedAccountListParams filters the dedicated accounts returned by
+// ListNWithParams and ListAllWithParams
+type DedicatedAccountListParams struct {
+	// Active lists only active or only deactivated accounts when set
+	Active       *bool
+	Currency     Currency
+	ProviderSlug string
+	BankID       int
+	// Customer is the ID of the customer the accounts belong to
+	Customer int
+}
+
+func (p *DedicatedAccountListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Active != nil {
+		q.Set("active", strconv.FormatBool(*p.Active))
+	}
+	if p.Currency != "" {
+		q.Set("currency", string(p.Currency))
+	}
+	if p.ProviderSlug != "" {
+		q.Set("provider_slug", p.ProviderSlug)
+	}
+	setInt(q, "bank_id", int64(p.BankID))
+	setInt(q, "customer", int64(p.Customer))
+	return q
+}
+
+// Create creates a dedicated account for an existing customer
+// For more details see https://developers.paystack.co/v1.0/reference#create-dedicated-account
+func (s *DedicatedAccountService) Create(req *DedicatedAccountRequest) (*DedicatedAccount, error) {
+	return s.CreateWithContext(context.Background(), req)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *DedicatedAccountService) CreateWithContext(ctx context.Context, req *DedicatedAccountRequest) (*DedicatedAccount, error) {
+	if req.Customer == "" {
+		return nil, newRequestValidationError("customer", "customer is required")
+	}
+	account := &DedicatedAccount{}
+	err := s.client.call(ctx, "DedicatedAccount.Create", "POST", "/dedicated_account", req, account)
+	return account, err
+}
+
+// Assign creates a customer, validates them and assigns them a dedicated
+// account. It completes asynchronously: Paystack sends a
+// dedicatedaccount.assign.success or dedicatedaccount.assign.failed webhook.
+// For more details see https://developers.paystack.co/v1.0/reference#assign-dedicated-account
+func (s *DedicatedAccountService) Assign(req *DedicatedAccountAssignRequest) (*DedicatedAccountAssignResult, error) {
+	return s.AssignWithContext(context.Background(), req)
+}
+
+// AssignWithContext is like Assign but carries ctx through to the request
+func (s *DedicatedAccountService) AssignWithContext(ctx context.Context, req *DedicatedAccountAssignRequest) (*DedicatedAccountAssignResult, error) {
+	required := []struct{ field, value string }{
+		{"email", req.Email},
+		{"first_name", req.FirstName},
+		{"last_name", req.LastName},
+		{"phone", req.Phone},
+		{"preferred_bank", req.PreferredBank},
+		{"country", req.Country},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			return nil, newRequestValidationError(r.field, r.field+" is required")
+		}
+	}
+	result := &DedicatedAccountAssignResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "DedicatedAccount.Assign", "POST", "/dedicated_account/assign", req, result)
+	return result, err
+}
+
+// Get returns the details of a dedicated account.
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-dedicated-account
+func (s *DedicatedAccountService) Get(id int) (*DedicatedAccount, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *DedicatedAccountService) GetWithContext(ctx context.Context, id int) (*DedicatedAccount, error) {
+	u := fmt.Sprintf("/dedicated_account/%d", id)
+	account := &DedicatedAccount{}
+	err := s.client.call(ctx, "DedicatedAccount.Get", "GET", u, nil, account)
+	return account, err
+}
+
+// List returns a list of dedicated accounts.
+// For more details see https://developers.paystack.co/v1.0/reference#list-dedicated-accounts
+func (s *DedicatedAccountService) List() (*DedicatedAccountList, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *DedicatedAccountService) ListWithContext(ctx context.Context) (*DedicatedAccountList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
+}
+
+// ListN returns a list of dedicated accounts
+// For more details see https://developers.paystack.co/v1.0/reference#list-dedicated-accounts
+func (s *DedicatedAccountService) ListN(count, offset int) (*DedicatedAccountList, error) {
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *DedicatedAccountService) ListNWithContext(ctx context.Context, count, offset int) (*DedicatedAccountList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of dedicated accounts matching params
+func (s *DedicatedAccountService) ListNWithParams(count, offset int, params *DedicatedAccountListParams) (*DedicatedAccountList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *DedicatedAccountService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *DedicatedAccountListParams) (*DedicatedAccountList, error) {
+	u := paginateURL("/dedicated_account", count, offset, params.values())
+	accounts := &DedicatedAccountList{}
+	err := s.client.call(ctx, "DedicatedAccount.ListN", "GET", u, nil, accounts)
+	return accounts, err
+}
+
+// ListAll returns an iterator over all dedicated accounts, fetching pages as needed
+func (s *DedicatedAccountService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[DedicatedAccount] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all dedicated accounts matching params
+func (s *DedicatedAccountService) ListAllWithParams(ctx context.Context, params *DedicatedAccountListParams, opts *ListOptions) *Iterator[DedicatedAccount] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]DedicatedAccount, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
+
+// Requery asks Paystack to check the account for transfers it has not yet
+// notified you of. The date of the transfer is optional.
+// For more details see https://developers.paystack.co/v1.0/reference#requery-dedicated-account
+func (s *DedicatedAccountService) Requery(accountNumber, providerSlug string, date time.Time) (*DedicatedAccountRequeryResult, error) {
+	return s.RequeryWithContext(context.Background(), accountNumber, providerSlug, date)
+}
+
+// RequeryWithContext is like Requery but carries ctx through to the request
+func (s *DedicatedAccountService) RequeryWithContext(ctx context.Context, accountNumber, providerSlug string, date time.Time) (*DedicatedAccountRequeryResult, error) {
+	q := url.Values{}
+	q.Set("account_number", accountNumber)
+	q.Set("provider_slug", providerSlug)
+	if !date.IsZero() {
+		q.Set("date", date.Format("2006-01-02"))
+	}
+	result := &DedicatedAccountRequeryResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "DedicatedAccount.Requery", "GET", "/dedicated_account/requery?"+q.Encode(), nil, result)
+	return result, err
+}
+
+// Deactivate deactivates a dedicated account
+// For more details see https://developers.paystack.co/v1.0/reference#deactivate-dedicated-account
+func (s *DedicatedAccountService) Deactivate(id int) (*DedicatedAccount, error) {
+	return s.DeactivateWithContext(context.Background(), id)
+}
+
+// DeactivateWithContext is like Deactivate but carries ctx through to the request
+func (s *DedicatedAccountService) DeactivateWithContext(ctx context.Context, id int) (*DedicatedAccount, error) {
+	u := fmt.Sprintf("/dedicated_account/%d", id)
+	account := &DedicatedAccount{}
+	err := s.client.call(ctx, "DedicatedAccount.Deactivate", "DELETE", u, nil, account)
+	return account, err
+}
+
+// AddSplit shares the payments into a customer's dedicated account with a
+// subaccount or split, creating the account if the customer has none
+// For more details see https://developers.paystack.co/v1.0/reference#add-split-to-dedicated-account
+func (s *DedicatedAccountService) AddSplit(req *DedicatedAccountRequest) (*DedicatedAccount, error) {
+	return s.AddSplitWithContext(context.Background(), req)
+}
+
+// AddSplitWithContext is like AddSplit but carries ctx through to the request
+func (s *DedicatedAccountService) AddSplitWithContext(ctx context.Context, req *DedicatedAccountRequest) (*DedicatedAccount, error) {
+	if req.Customer == "" {
+		return nil, newRequestValidationError("customer", "customer is required")
+	}
+	if req.Subaccount == "" && req.SplitCode == "" {
+		return nil, newRequestValidationError("split_code", "subaccount or split_code is required")
+	}
+	account := &DedicatedAccount{}
+	err := s.client.call(ctx, "DedicatedAccount.AddSplit", "POST", "/dedicated_account/split", req, account)
+	return account, err
+}
+
+// RemoveSplit stops sharing the payments into a dedicated account
+// For more details see https://developers.paystack.co/v1.0/reference#remove-split-from-dedicated-account
+func (s *DedicatedAccountService) RemoveSplit(accountNumber string) (*DedicatedAccount, error) {
+	return s.RemoveSplitWithContext(context.Background(), accountNumber)
+}
+
+// RemoveSplitWithContext is like RemoveSplit but carries ctx through to the request
+func (s *DedicatedAccountService) RemoveSplitWithContext(ctx context.Context, accountNumber string) (*DedicatedAccount, error) {
+	body := map[string]string{"account_number": accountNumber}
+	account := &DedicatedAccount{}
+	err := s.client.call(ctx, "DedicatedAccount.RemoveSplit", "DELETE", "/dedicated_account/split", body, account)
+	return account, err
+}
+
+// AvailableProviders returns the banks dedicated accounts can be opened with
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-bank-providers
+func (s *DedicatedAccountService) AvailableProviders() ([]DedicatedAccountProvider, error) {
+	return s.AvailableProvidersWithContext(context.Background())
+}
+
+// AvailableProvidersWithContext is like AvailableProviders but carries ctx through to the request
+func (s *DedicatedAccountService) AvailableProvidersWithContext(ctx context.Context) ([]DedicatedAccountProvider, error) {
+	resp := Response{}
+	if err := s.client.call(ctx, "DedicatedAccount.AvailableProviders", "GET", "/dedicated_account/available_providers", nil, &resp); err != nil {
+		return nil, err
+	}
+	// the 'data' node is an array
+	providers := []DedicatedAccountProvider{}
+	err := mapstruct(resp["data"], &providers)
+	return providers, err
+}
@@ -0,0 +1,110 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rpip/paystack-go/paystacktest"
+)
+
+func TestDedicatedAccounts(t *testing.T) {
+	srv := paystacktest.NewServer()
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	providers, err := client.DedicatedAccount.AvailableProviders()
+	if err != nil || len(providers) == 0 || providers[0].ProviderSlug == "" || providers[0].BankName == "" {
+		t.Fatalf("Expected available providers, got %+v, %v", providers, err)
+	}
+
+	cust, err := client.Customer.Create(&Customer{Email: "ada@example.com", FirstName: "Ada", LastName: "Lovelace"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.DedicatedAccount.Create(&DedicatedAccountRequest{}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected missing customer to fail validation, got %v", err)
+	}
+	account, err := client.DedicatedAccount.Create(&DedicatedAccountRequest{Customer: cust.CustomerCode, PreferredBank: "wema-bank"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !account.Active || account.AccountNumber == "" || account.Bank.Slug != "wema-bank" || account.Currency != NGN {
+		t.Errorf("Unexpected dedicated account %+v", account)
+	}
+	if account.Customer.CustomerCode != cust.CustomerCode || account.Assignment == nil || account.Assignment.AssigneeID != cust.ID {
+		t.Errorf("Expected the account to be tied to the customer, got %+v", account)
+	}
+	if _, err := client.DedicatedAccount.Create(&DedicatedAccountRequest{Customer: cust.CustomerCode, PreferredBank: "wema-bank"}); err == nil {
+		t.Error("Expected a second account with the same bank to fail")
+	}
+
+	if _, err := client.DedicatedAccount.Assign(&DedicatedAccountAssignRequest{Email: "grace@example.com"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected incomplete assign request to fail validation, got %v", err)
+	}
+	assigned, err := client.DedicatedAccount.Assign(&DedicatedAccountAssignRequest{
+		Email: "grace@example.com", FirstName: "Grace", LastName: "Hopper", Phone: "+2348100000000",
+		PreferredBank: "test-bank", Country: "NG",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assigned.Status || assigned.Message == "" || assigned.Raw["message"] != assigned.Message {
+		t.Errorf("Expected the assignment to be acknowledged, got %+v", assigned)
+	}
+
+	got, err := client.DedicatedAccount.Get(account.ID)
+	if err != nil || got.AccountNumber != account.AccountNumber {
+		t.Errorf("Expected to fetch the account, got %+v, %v", got, err)
+	}
+	if _, err := client.DedicatedAccount.Get(9999); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+
+	list, err := client.DedicatedAccount.ListNWithParams(10, 1, &DedicatedAccountListParams{ProviderSlug: "test-bank"})
+	if err != nil || len(list.Values) != 1 || list.Values[0].Customer.Email != "grace@example.com" {
+		t.Errorf("Expected the assigned account only, got %+v, %v", list, err)
+	}
+
+	requery, err := client.DedicatedAccount.Requery(account.AccountNumber, "wema-bank", time.Now())
+	if err != nil || !requery.Status || requery.Message == "" {
+		t.Errorf("Expected requery to be accepted, got %+v, %v", requery, err)
+	}
+
+	sub, err := client.SubAccount.Create(&SubAccount{
+		BusinessName: "Sunshine Studios", SettlementBank: "044", AccountNumber: "0193278965", PercentageCharge: 18.2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.DedicatedAccount.AddSplit(&DedicatedAccountRequest{Customer: cust.CustomerCode}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected split without subaccount or split code to fail validation, got %v", err)
+	}
+	split, err := client.DedicatedAccount.AddSplit(&DedicatedAccountRequest{Customer: cust.CustomerCode, Subaccount: sub.SubAccountCode})
+	if err != nil || split.ID != account.ID || split.SplitConfig == nil {
+		t.Errorf("Expected split on the existing account, got %+v, %v", split, err)
+	}
+	if split, err = client.DedicatedAccount.RemoveSplit(account.AccountNumber); err != nil || split.SplitConfig != nil {
+		t.Errorf("Expected split to be removed, got %+v, %v", split, err)
+	}
+
+	deactivated, err := client.DedicatedAccount.Deactivate(account.ID)
+	if err != nil || deactivated.Active || !deactivated.Assignment.Expired {
+		t.Errorf("Expected deactivated account, got %+v, %v", deactivated, err)
+	}
+	active := true
+	var n int
+	for acct, err := range client.DedicatedAccount.ListAllWithParams(context.Background(), &DedicatedAccountListParams{Active: &active}, nil).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !acct.Active {
+			t.Errorf("Expected active accounts only, got %+v", acct)
+		}
+		n++
+	}
+	if n != 1 {
+		t.Errorf("Expected 1 active account, got %d", n)
+	}
+}
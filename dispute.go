@@ -0,0 +1,307 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// DisputeService handles operations related to disputes
+// For more details see https://developers.paystack.co/v1.0/reference#list-disputes
+type DisputeService service
+
+// Dispute statuses
+const (
+	DisputeAwaitingMerchantFeedback = "awaiting-merchant-feedback"
+	DisputeAwaitingBankFeedback     = "awaiting-bank-feedback"
+	DisputePending                  = "pending"
+	DisputeResolved                 = "resolved"
+)
+
+// Dispute resolutions
+const (
+	// DisputeMerchantAccepted accepts the dispute and refunds the customer
+	DisputeMerchantAccepted = "merchant-accepted"
+	// DisputeDeclined rejects the dispute with evidence that the customer got value
+	DisputeDeclined = "declined"
+)
+
+// Dispute represents a chargeback or fraud claim raised against a transaction
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-dispute
+type Dispute struct {
+	ID                   int              `json:"id,omitempty"`
+	CreatedAt            string           `json:"createdAt,omitempty"`
+	UpdatedAt            string           `json:"updatedAt,omitempty"`
+	Domain               string           `json:"domain,omitempty"`
+	Integration          int              `json:"integration,omitempty"`
+	RefundAmount         Amount           `json:"refund_amount,omitempty"`
+	Currency             Currency         `json:"currency,omitempty"`
+	Status               string           `json:"status,omitempty"`
+	Resolution           string           `json:"resolution,omitempty"`
+	Category             string           `json:"category,omitempty"`
+	Note                 string           `json:"note,omitempty"`
+	Transaction          Transaction      `json:"transaction,omitempty"`
+	TransactionReference string           `json:"transaction_reference,omitempty"`
+	Customer             Customer         `json:"customer,omitempty"`
+	BIN                  string           `json:"bin,omitempty"`
+	Last4                string           `json:"last4,omitempty"`
+	DueAt                string           `json:"dueAt,omitempty"`
+	ResolvedAt           string           `json:"resolvedAt,omitempty"`
+	Evidence             *DisputeEvidence `json:"evidence,omitempty"`
+	Attachments          interface{}      `json:"attachments,omitempty"`
+	History              []DisputeHistory `json:"history,omitempty"`
+	Messages             []DisputeMessage `json:"messages,omitempty"`
+}
+
+// DisputeEvidence is the proof of service given to contest a dispute
+// For more details see https://developers.paystack.co/v1.0/reference#add-evidence
+type DisputeEvidence struct {
+	ID              int    `json:"id,omitempty"`
+	CreatedAt       string `json:"createdAt,omitempty"`
+	UpdatedAt       string `json:"updatedAt,omitempty"`
+	Dispute         int    `json:"dispute,omitempty"`
+	CustomerEmail   string `json:"customer_email,omitempty"`
+	CustomerName    string `json:"customer_name,omitempty"`
+	CustomerPhone   string `json:"customer_phone,omitempty"`
+	ServiceDetails  string `json:"service_details,omitempty"`
+	DeliveryAddress string `json:"delivery_address,omitempty"`
+	DeliveryDate    string `json:"delivery_date,omitempty"`
+}
+
+// DisputeHistory is a status change of a dispute
+type DisputeHistory struct {
+	Status    string `json:"status,omitempty"`
+	By        string `json:"by,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// DisputeMessage is a message exchanged about a dispute
+type DisputeMessage struct {
+	Sender    string `json:"sender,omitempty"`
+	Body      string `json:"body,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// DisputeList is a list object for disputes.
+type DisputeList struct {
+	Meta   ListMeta
+	Values []Dispute `json:"data,omitempty"`
+}
+
+// DisputeListParams filters the disputes returned by ListNWithParams,
+// ListAllWithParams and Export
+type DisputeListParams struct {
+	From time.Time
+	To   time.Time
+	// Status is one of the Dispute statuses, such as DisputeAwaitingMerchantFeedback
+	Status string
+	// Transaction is the ID of the disputed transaction
+	Transaction int
+}
+
+func (p *DisputeListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	setTime(q, "from", p.From)
+	setTime(q, "to", p.To)
+	if p.Status != "" {
+		q.Set("status", p.Status)
+	}
+	setInt(q, "transaction", int64(p.Transaction))
+	return q
+}
+
+// DisputeUpdate represents a request to update a dispute
+type DisputeUpdate struct {
+	// RefundAmount is the amount to refund, if the dispute is accepted
+	RefundAmount Amount `json:"refund_amount"`
+	// UploadedFilename is the name of a file uploaded through UploadURL
+	UploadedFilename string `json:"uploaded_filename,omitempty"`
+}
+
+// DisputeResolution represents a request to resolve a dispute
+type DisputeResolution struct {
+	// Resolution is DisputeMerchantAccepted or DisputeDeclined
+	Resolution   string `json:"resolution"`
+	Message      string `json:"message"`
+	RefundAmount Amount `json:"refund_amount"`
+	// UploadedFilename is the name of a file uploaded through UploadURL
+	UploadedFilename string `json:"uploaded_filename"`
+	// Evidence is the ID of the evidence added for a declined dispute
+	Evidence int `json:"evidence,omitempty"`
+}
+
+// DisputeUploadURL is a signed URL to upload a file of proof to
+type DisputeUploadURL struct {
+	SignedURL string `json:"signedUrl,omitempty"`
+	FileName  string `json:"fileName,omitempty"`
+	// ExpiresIn is the lifetime of the URL in seconds
+	ExpiresIn int `json:"expiresIn,omitempty"`
+}
+
+// List returns a list of disputes.
+// For more details see https://developers.paystack.co/v1.0/reference#list-disputes
+func (s *DisputeService) List() (*DisputeList, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *DisputeService) ListWithContext(ctx context.Context) (*DisputeList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
+}
+
+// ListN returns a list of disputes
+// For more details see https://developers.paystack.co/v1.0/reference#list-disputes
+func (s *DisputeService) ListN(count, offset int) (*DisputeList, error) {
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *DisputeService) ListNWithContext(ctx context.Context, count, offset int) (*DisputeList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of disputes matching params
+func (s *DisputeService) ListNWithParams(count, offset int, params *DisputeListParams) (*DisputeList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *DisputeService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *DisputeListParams) (*DisputeList, error) {
+	u := paginateURL("/dispute", count, offset, params.values())
+	disputes := &DisputeList{}
+	err := s.client.call(ctx, "Dispute.ListN", "GET", u, nil, disputes)
+	return disputes, err
+}
+
+// ListAll returns an iterator over all disputes, fetching pages as needed
+func (s *DisputeService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Dispute] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all disputes matching params
+func (s *DisputeService) ListAllWithParams(ctx context.Context, params *DisputeListParams, opts *ListOptions) *Iterator[Dispute] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Dispute, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
+
+// Get returns the details of a dispute.
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-dispute
+func (s *DisputeService) Get(id int) (*Dispute, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *DisputeService) GetWithContext(ctx context.Context, id int) (*Dispute, error) {
+	u := fmt.Sprintf("/dispute/%d", id)
+	dispute := &Dispute{}
+	err := s.client.call(ctx, "Dispute.Get", "GET", u, nil, dispute)
+	return dispute, err
+}
+
+// ListByTransaction returns the disputes raised against a transaction.
+// For more details see https://developers.paystack.co/v1.0/reference#list-transaction-disputes
+func (s *DisputeService) ListByTransaction(transactionID int) ([]Dispute, error) {
+	return s.ListByTransactionWithContext(context.Background(), transactionID)
+}
+
+// ListByTransactionWithContext is like ListByTransaction but carries ctx through to the request
+func (s *DisputeService) ListByTransactionWithContext(ctx context.Context, transactionID int) ([]Dispute, error) {
+	u := fmt.Sprintf("/dispute/transaction/%d", transactionID)
+	dispute := Dispute{}
+	raw, err := s.client.callRaw(ctx, "Dispute.ListByTransaction", "GET", u, nil, &dispute)
+	if err != nil {
+		return nil, err
+	}
+	// Paystack answers with the dispute itself, or with null when there is
+	// none, in which case raw is the whole envelope
+	if _, none := raw["data"]; none {
+		return nil, nil
+	}
+	return []Dispute{dispute}, nil
+}
+
+// Update updates the refund amount of a dispute or attaches an uploaded file
+// For more details see https://developers.paystack.co/v1.0/reference#update-dispute
+func (s *DisputeService) Update(id int, update *DisputeUpdate) (*Dispute, error) {
+	return s.UpdateWithContext(context.Background(), id, update)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the request
+func (s *DisputeService) UpdateWithContext(ctx context.Context, id int, update *DisputeUpdate) (*Dispute, error) {
+	u := fmt.Sprintf("/dispute/%d", id)
+	dispute := &Dispute{}
+	err := s.client.call(ctx, "Dispute.Update", "PUT", u, update, dispute)
+	return dispute, err
+}
+
+// AddEvidence submits proof that the customer got the goods or service
+// For more details see https://developers.paystack.co/v1.0/reference#add-evidence
+func (s *DisputeService) AddEvidence(id int, evidence *DisputeEvidence) (*DisputeEvidence, error) {
+	return s.AddEvidenceWithContext(context.Background(), id, evidence)
+}
+
+// AddEvidenceWithContext is like AddEvidence but carries ctx through to the request
+func (s *DisputeService) AddEvidenceWithContext(ctx context.Context, id int, evidence *DisputeEvidence) (*DisputeEvidence, error) {
+	u := fmt.Sprintf("/dispute/%d/evidence", id)
+	ev := &DisputeEvidence{}
+	err := s.client.call(ctx, "Dispute.AddEvidence", "POST", u, evidence, ev)
+	return ev, err
+}
+
+// UploadURL returns a signed URL to upload a file of proof for a dispute to
+// For more details see https://developers.paystack.co/v1.0/reference#get-upload-url
+func (s *DisputeService) UploadURL(id int, filename string) (*DisputeUploadURL, error) {
+	return s.UploadURLWithContext(context.Background(), id, filename)
+}
+
+// UploadURLWithContext is like UploadURL but carries ctx through to the request
+func (s *DisputeService) UploadURLWithContext(ctx context.Context, id int, filename string) (*DisputeUploadURL, error) {
+	u := fmt.Sprintf("/dispute/%d/upload_url?%s", id, url.Values{"upload_filename": {filename}}.Encode())
+	upload := &DisputeUploadURL{}
+	err := s.client.call(ctx, "Dispute.UploadURL", "GET", u, nil, upload)
+	return upload, err
+}
+
+// Resolve accepts or declines a dispute
+// For more details see https://developers.paystack.co/v1.0/reference#resolve-dispute
+func (s *DisputeService) Resolve(id int, resolution *DisputeResolution) (*Dispute, error) {
+	return s.ResolveWithContext(context.Background(), id, resolution)
+}
+
+// ResolveWithContext is like Resolve but carries ctx through to the request
+func (s *DisputeService) ResolveWithContext(ctx context.Context, id int, resolution *DisputeResolution) (*Dispute, error) {
+	if resolution == nil {
+		return nil, newRequestValidationError("resolution", "resolution is required")
+	}
+	if resolution.Resolution != DisputeMerchantAccepted && resolution.Resolution != DisputeDeclined {
+		return nil, newRequestValidationError("resolution", fmt.Sprintf("unknown resolution %q", resolution.Resolution))
+	}
+	u := fmt.Sprintf("/dispute/%d/resolve", id)
+	dispute := &Dispute{}
+	err := s.client.call(ctx, "Dispute.Resolve", "PUT", u, resolution, dispute)
+	return dispute, err
+}
+
+// Export exports disputes to a downloadable file and returns a link to the file
+// For more details see https://developers.paystack.co/v1.0/reference#export-disputes
+func (s *DisputeService) Export(params *DisputeListParams) (*ExportResult, error) {
+	return s.ExportWithContext(context.Background(), params)
+}
+
+// ExportWithContext is like Export but carries ctx through to the request
+func (s *DisputeService) ExportWithContext(ctx context.Context, params *DisputeListParams) (*ExportResult, error) {
+	u := "/dispute/export"
+	if q := params.values(); len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	result := &ExportResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Dispute.Export", "GET", u, nil, result)
+	return result, err
+}
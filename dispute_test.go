@@ -0,0 +1,119 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rpip/paystack-go/paystacktest"
+)
+
+func TestDisputes(t *testing.T) {
+	srv := paystacktest.NewServer()
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	var ids []int
+	for _, ref := range []string{"dispute-1", "dispute-2"} {
+		if _, err := client.Transaction.Initialize(&TransactionRequest{Email: "ada@example.com", Amount: 500000, Reference: ref}); err != nil {
+			t.Fatal(err)
+		}
+		if err := srv.CompleteTransaction(ref); err != nil {
+			t.Fatal(err)
+		}
+		id, err := srv.OpenDispute(ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+
+	dispute, err := client.Dispute.Get(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dispute.Status != DisputeAwaitingMerchantFeedback || dispute.RefundAmount != 500000 || dispute.Currency != NGN {
+		t.Errorf("Unexpected dispute %+v", dispute)
+	}
+	if dispute.Transaction.Reference != "dispute-1" || dispute.Customer.Email != "ada@example.com" || len(dispute.History) != 1 {
+		t.Errorf("Expected the transaction, customer and history to be decoded, got %+v", dispute)
+	}
+	if _, err := client.Dispute.Get(9999); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+
+	byTxn, err := client.Dispute.ListByTransaction(dispute.Transaction.ID)
+	if err != nil || len(byTxn) != 1 || byTxn[0].ID != ids[0] {
+		t.Errorf("Expected the dispute for the transaction, got %+v, %v", byTxn, err)
+	}
+	client.Transaction.Initialize(&TransactionRequest{Email: "ada@example.com", Amount: 500000, Reference: "undisputed"})
+	txn, _ := client.Transaction.Verify("undisputed")
+	if byTxn, err := client.Dispute.ListByTransaction(txn.ID); err != nil || len(byTxn) != 0 {
+		t.Errorf("Expected no disputes, got %+v, %v", byTxn, err)
+	}
+
+	if dispute, err = client.Dispute.Update(ids[0], &DisputeUpdate{RefundAmount: 200000}); err != nil || dispute.RefundAmount != 200000 {
+		t.Errorf("Expected updated refund amount, got %+v, %v", dispute, err)
+	}
+
+	upload, err := client.Dispute.UploadURL(ids[1], "receipt.pdf")
+	if err != nil || upload.SignedURL == "" || upload.FileName != "receipt.pdf" {
+		t.Errorf("Expected an upload URL, got %+v, %v", upload, err)
+	}
+
+	// declining needs evidence first
+	decline := &DisputeResolution{
+		Resolution: DisputeDeclined, Message: "Goods were delivered", RefundAmount: 0, UploadedFilename: "receipt.pdf",
+	}
+	if _, err := client.Dispute.Resolve(ids[1], decline); err == nil {
+		t.Error("Expected a decline without evidence to fail")
+	}
+	if _, err := client.Dispute.AddEvidence(ids[1], &DisputeEvidence{CustomerEmail: "ada@example.com"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected incomplete evidence to fail validation, got %v", err)
+	}
+	evidence, err := client.Dispute.AddEvidence(ids[1], &DisputeEvidence{
+		CustomerEmail: "ada@example.com", CustomerName: "Ada Lovelace", CustomerPhone: "08012345678",
+		ServiceDetails: "Two tickets", DeliveryAddress: "1 Marina, Lagos",
+	})
+	if err != nil || evidence.ID == 0 || evidence.Dispute != ids[1] {
+		t.Fatalf("Expected evidence for the dispute, got %+v, %v", evidence, err)
+	}
+	decline.Evidence = evidence.ID
+	dispute, err = client.Dispute.Resolve(ids[1], decline)
+	if err != nil || dispute.Status != DisputeResolved || dispute.Resolution != DisputeDeclined || dispute.ResolvedAt == "" {
+		t.Fatalf("Expected declined dispute, got %+v, %v", dispute, err)
+	}
+	if dispute.Evidence == nil || dispute.Evidence.CustomerName != "Ada Lovelace" || len(dispute.History) != 2 {
+		t.Errorf("Expected evidence and history on the resolved dispute, got %+v", dispute)
+	}
+	if _, err := client.Dispute.Resolve(ids[1], decline); err == nil {
+		t.Error("Expected a resolved dispute to be rejected")
+	}
+	if _, err := client.Dispute.Resolve(ids[0], &DisputeResolution{Resolution: "maybe"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected unknown resolution to fail validation, got %v", err)
+	}
+	if _, err := client.Dispute.Resolve(ids[0], nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil resolution to fail validation, got %v", err)
+	}
+
+	list, err := client.Dispute.ListNWithParams(10, 1, &DisputeListParams{Status: DisputeResolved})
+	if err != nil || len(list.Values) != 1 || list.Values[0].ID != ids[1] {
+		t.Errorf("Expected the resolved dispute only, got %+v, %v", list, err)
+	}
+	var n int
+	for _, err := range client.Dispute.ListAll(context.Background(), &ListOptions{PerPage: 1}).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 2 {
+		t.Errorf("Expected to iterate over 2 disputes, got %d", n)
+	}
+
+	export, err := client.Dispute.Export(&DisputeListParams{Status: DisputeResolved})
+	if err != nil || !strings.Contains(export.Path, "status=resolved") {
+		t.Errorf("Expected export link with the filters, got %+v, %v", export, err)
+	}
+}
@@ -1,10 +1,35 @@
 package paystack
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors classifying failed requests. Use errors.Is to test an error
+// returned by the client against them, and errors.As to get at the details
+// through *APIError, *ValidationError or *NetworkError.
+var (
+	// ErrAuthentication means the API key was missing, invalid or not allowed to make the call
+	ErrAuthentication = errors.New("paystack: authentication failed")
+	// ErrValidation means Paystack rejected the request parameters
+	ErrValidation = errors.New("paystack: invalid request")
+	// ErrNotFound means the requested resource does not exist
+	ErrNotFound = errors.New("paystack: not found")
+	// ErrRateLimited means too many requests were made in a short period
+	ErrRateLimited = errors.New("paystack: rate limited")
+	// ErrDuplicateReference means the request's reference has already been used.
+	// For a retried transfer or charge this means the original attempt went through.
+	ErrDuplicateReference = errors.New("paystack: duplicate reference")
+	// ErrInsufficientBalance means the balance cannot cover a transfer or charge
+	ErrInsufficientBalance = errors.New("paystack: insufficient balance")
+	// ErrServer means Paystack failed to process the request
+	ErrServer = errors.New("paystack: server error")
+	// ErrNetwork means the request or response never made it across the network
+	ErrNetwork = errors.New("paystack: network error")
 )
 
 // APIError includes the response from the Paystack API and some HTTP request info
@@ -14,12 +39,89 @@ type APIError struct {
 	Details        ErrorResponse `json:"details,omitempty"`
 	URL            *url.URL      `json:"url,omitempty"`
 	Header         http.Header   `json:"header,omitempty"`
+	// Body is the raw response body as received from Paystack
+	Body []byte `json:"-"`
+
+	kind error
 }
 
 // APIError supports the error interface
 func (aerr *APIError) Error() string {
-	ret, _ := json.Marshal(aerr)
-	return string(ret)
+	msg := aerr.Message
+	if msg == "" {
+		msg = http.StatusText(aerr.HTTPStatusCode)
+	}
+	return fmt.Sprintf("paystack: %s (HTTP %d)", msg, aerr.HTTPStatusCode)
+}
+
+// Unwrap exposes the sentinel error classifying the failure, if any
+func (aerr *APIError) Unwrap() error {
+	return aerr.kind
+}
+
+// ValidationError is returned when the request parameters are rejected,
+// either by Paystack or by the client before the request is sent, in which
+// case API is nil. Fields maps each offending parameter to its problems.
+type ValidationError struct {
+	// API is the error answered by Paystack, or nil when the client rejected
+	// the request itself
+	API    *APIError
+	Fields map[string][]string
+}
+
+// newRequestValidationError reports a problem found before sending a request
+func newRequestValidationError(field, problem string) *ValidationError {
+	return &ValidationError{Fields: map[string][]string{field: {problem}}}
+}
+
+// Error lists the per-field problems after the API message
+func (verr *ValidationError) Error() string {
+	msg := ErrValidation.Error()
+	if verr.API != nil {
+		msg = verr.API.Error()
+	}
+	if len(verr.Fields) == 0 {
+		return msg
+	}
+	names := make([]string, 0, len(verr.Fields))
+	for name := range verr.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + ": " + strings.Join(verr.Fields[name], ", ")
+	}
+	return msg + ": " + strings.Join(parts, "; ")
+}
+
+// Unwrap returns the underlying APIError, or ErrValidation for client side errors
+func (verr *ValidationError) Unwrap() error {
+	if verr.API == nil {
+		return ErrValidation
+	}
+	return verr.API
+}
+
+// NetworkError wraps a transport failure, such as a refused connection or
+// a timeout, that prevented a response from being received
+type NetworkError struct {
+	Err error
+}
+
+// Error supports the error interface
+func (nerr *NetworkError) Error() string {
+	return "paystack: network error: " + nerr.Err.Error()
+}
+
+// Is makes errors.Is(err, ErrNetwork) report true
+func (nerr *NetworkError) Is(target error) bool {
+	return target == ErrNetwork
+}
+
+// Unwrap returns the transport error
+func (nerr *NetworkError) Unwrap() error {
+	return nerr.Err
 }
 
 // ErrorResponse represents an error response from the Paystack API server
@@ -29,15 +131,87 @@ type ErrorResponse struct {
 	Errors  map[string]interface{} `json:"errors,omitempty"`
 }
 
-func newAPIError(resp *http.Response) *APIError {
-	p, _ := ioutil.ReadAll(resp.Body)
-
-	var paystackErrorResp ErrorResponse
-	_ = json.Unmarshal(p, &paystackErrorResp)
-	return &APIError{
+// newAPIError builds the error for a failed response whose body has already been read
+func newAPIError(resp *http.Response, body []byte, details ErrorResponse) error {
+	aerr := &APIError{
+		Message:        details.Message,
 		HTTPStatusCode: resp.StatusCode,
 		Header:         resp.Header,
-		Details:        paystackErrorResp,
-		URL:            resp.Request.URL,
+		Details:        details,
+		Body:           body,
+	}
+	if resp.Request != nil {
+		aerr.URL = resp.Request.URL
+	}
+	aerr.kind = classify(resp.StatusCode, details.Message)
+
+	if aerr.kind == ErrValidation {
+		return &ValidationError{API: aerr, Fields: fieldErrors(details.Errors)}
+	}
+	return aerr
+}
+
+// classify maps a failed response to one of the sentinel errors
+func classify(status int, msg string) error {
+	switch {
+	case isDuplicateReference(msg):
+		return ErrDuplicateReference
+	case isInsufficientBalance(msg):
+		return ErrInsufficientBalance
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ErrAuthentication
+	case status == http.StatusNotFound:
+		return ErrNotFound
+	case status == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case status >= 500:
+		return ErrServer
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return ErrValidation
+	}
+	return nil
+}
+
+// isDuplicateReference reports whether a Paystack error message complains
+// about a reference that was already used
+func isDuplicateReference(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "reference") &&
+		(strings.Contains(msg, "duplicate") || strings.Contains(msg, "already"))
+}
+
+// isInsufficientBalance reports whether a Paystack error message complains about the balance
+func isInsufficientBalance(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "balance") &&
+		(strings.Contains(msg, "insufficient") || strings.Contains(msg, "not enough"))
+}
+
+// fieldErrors flattens the "errors" object of a validation response. Paystack
+// reports each field's problems as a string, a list of strings or a list of
+// objects with a message.
+func fieldErrors(errs map[string]interface{}) map[string][]string {
+	fields := make(map[string][]string, len(errs))
+	for name, v := range errs {
+		switch t := v.(type) {
+		case string:
+			fields[name] = append(fields[name], t)
+		case []interface{}:
+			for _, item := range t {
+				switch it := item.(type) {
+				case string:
+					fields[name] = append(fields[name], it)
+				case map[string]interface{}:
+					if m, ok := it["message"].(string); ok {
+						fields[name] = append(fields[name], m)
+					}
+				}
+			}
+		case map[string]interface{}:
+			if m, ok := t["message"].(string); ok {
+				fields[name] = append(fields[name], m)
+			}
+		}
 	}
+	return fields
 }
@@ -0,0 +1,106 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestErrorClassification(t *testing.T) {
+	cases := []struct {
+		status int
+		body   string
+		want   error
+	}{
+		{401, `{"status":false,"message":"Invalid key"}`, ErrAuthentication},
+		{404, `{"status":false,"message":"Customer not found"}`, ErrNotFound},
+		{429, `{"status":false,"message":"Too many requests"}`, ErrRateLimited},
+		{502, `<html>Bad Gateway</html>`, ErrServer},
+		{400, `{"status":false,"message":"Duplicate Transaction Reference"}`, ErrDuplicateReference},
+		{400, `{"status":false,"message":"Your balance is not enough to fulfil this request"}`, ErrInsufficientBalance},
+		{400, `{"status":false,"message":"Invalid data","errors":{"email":[{"message":"Email is required"}]}}`, ErrValidation},
+	}
+
+	for _, tc := range cases {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			w.Write([]byte(tc.body))
+		}))
+		client := newTestClient(ts.URL)
+		client.RetryPolicy = nil
+
+		_, err := client.Customer.Get("CUS_1")
+		ts.Close()
+
+		if !errors.Is(err, tc.want) {
+			t.Errorf("HTTP %d %s: expected %v, got %v", tc.status, tc.body, tc.want, err)
+		}
+		var aerr *APIError
+		if !errors.As(err, &aerr) {
+			t.Fatalf("Expected *APIError, got %T", err)
+		}
+		if string(aerr.Body) != tc.body {
+			t.Errorf("Expected body %q to be preserved, got %q", tc.body, aerr.Body)
+		}
+	}
+}
+
+func TestValidationErrorFields(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":false,"message":"Invalid data","errors":{"amount":["Amount is required"],"email":[{"type":"any.required","message":"Email is required"}]}}`))
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	_, err := client.Transaction.Initialize(&TransactionRequest{})
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected *ValidationError, got %T", err)
+	}
+	if verr.Fields["email"][0] != "Email is required" || verr.Fields["amount"][0] != "Amount is required" {
+		t.Errorf("Unexpected field errors %v", verr.Fields)
+	}
+	if verr.API == nil || verr.API.HTTPStatusCode != http.StatusBadRequest {
+		t.Errorf("Expected the API error to be kept, got %+v", verr.API)
+	}
+	want := "paystack: Invalid data (HTTP 400): amount: Amount is required; email: Email is required"
+	if err.Error() != want {
+		t.Errorf("Expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestRequestValidationError(t *testing.T) {
+	err := error(newRequestValidationError("amount", "amount must be positive"))
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.API != nil || !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expected a client side validation error, got %#v", err)
+	}
+	var aerr *APIError
+	if errors.As(err, &aerr) {
+		t.Errorf("Expected no API error, got %v", aerr)
+	}
+	if want := "paystack: invalid request: amount: amount must be positive"; err.Error() != want {
+		t.Errorf("Expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestNetworkError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	ts.Close()
+
+	client := newTestClient(ts.URL)
+	client.RetryPolicy = nil
+
+	_, err := client.Plan.Get(1)
+	if !errors.Is(err, ErrNetwork) {
+		t.Errorf("Expected ErrNetwork, got %v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "paystack: network error") {
+		t.Errorf("Unexpected message %q", err.Error())
+	}
+}
@@ -1,5 +1,5 @@
 module github.com/rpip/paystack-go
 
-go 1.16
+go 1.23
 
 require github.com/mitchellh/mapstructure v0.0.0-20170125051937-db1efb556f84
@@ -0,0 +1,59 @@
+package paystack
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// idempotencyKeyCtx is the context key under which the idempotency key is stored
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey returns a copy of ctx that makes requests carry key in the
+// Idempotency-Key header. Requests with a key are retried by the RetryPolicy
+// even when their HTTP method is not idempotent, since every attempt refers to
+// the same operation.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+// idempotencyKey returns the key stored in ctx, if any
+func idempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyCtx{}).(string)
+	return key
+}
+
+// NewReference returns a random reference suitable for transactions, charges and transfers
+func NewReference() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic("paystack: cannot generate reference: " + err.Error())
+	}
+	return hex.EncodeToString(b)
+}
+
+// ensureReference fills ref with a new reference if it is empty and returns it
+func ensureReference(ref *string) string {
+	if *ref == "" {
+		*ref = NewReference()
+	}
+	return *ref
+}
+
+// withReference makes sure ref is set and, unless the caller already chose
+// an idempotency key, uses it as the key for the request
+func withReference(ctx context.Context, ref *string) context.Context {
+	key := ensureReference(ref)
+	if idempotencyKey(ctx) != "" {
+		return ctx
+	}
+	return WithIdempotencyKey(ctx, key)
+}
+
+// batchKey derives a deterministic idempotency key from a set of item references
+func batchKey(refs []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(refs, "\n")))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,76 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestTransferRetryReusesReference(t *testing.T) {
+	var mu sync.Mutex
+	var keys, refs []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body TransferRequest
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		defer mu.Unlock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		refs = append(refs, body.Reference)
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Transfer has been queued","data":{"transfer_code":"TRF_1"}}`))
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	client.RetryPolicy = fastRetryPolicy()
+
+	req := &TransferRequest{Source: "balance", Amount: 500, Recipient: "RCP_1"}
+	if _, err := client.Transfer.Initiate(req); err != nil {
+		t.Fatalf("Expected retried transfer to succeed, got %v", err)
+	}
+
+	if req.Reference == "" {
+		t.Fatal("Expected a generated reference on the request")
+	}
+	if len(refs) != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", len(refs))
+	}
+	for i := range refs {
+		if refs[i] != req.Reference || keys[i] != req.Reference {
+			t.Errorf("Attempt %d sent reference %q and key %q, want %q", i+1, refs[i], keys[i], req.Reference)
+		}
+	}
+}
+
+func TestDuplicateReferenceError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":false,"message":"Duplicate Transaction Reference"}`))
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	_, err := client.Transaction.ChargeAuthorization(&TransactionRequest{Reference: "ref-1", Amount: 100})
+	if !errors.Is(err, ErrDuplicateReference) {
+		t.Errorf("Expected ErrDuplicateReference, got %v", err)
+	}
+}
+
+func TestBulkChargeKeyIsDeterministic(t *testing.T) {
+	items := []BulkItem{{Authorization: "AUTH_1", Amount: 100}, {Authorization: "AUTH_2", Amount: 200, Reference: "fixed"}}
+	refs := []string{ensureReference(&items[0].Reference), ensureReference(&items[1].Reference)}
+
+	if refs[1] != "fixed" {
+		t.Errorf("Expected caller reference to be kept, got %v", refs[1])
+	}
+	if batchKey(refs) != batchKey([]string{items[0].Reference, items[1].Reference}) {
+		t.Error("Expected the same key for the same references")
+	}
+}
@@ -0,0 +1,544 @@
+// Command apigen generates the service interfaces of package paystack and
+// the mocks of package paystackmock from the exported methods of the
+// services and of Client.
+//
+// It is run by go generate in the module root and writes api_gen.go and
+// paystackmock/mock_gen.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	header     = "// Code generated by apigen. DO NOT EDIT.\n\n"
+	modulePath = "github.com/rpip/paystack-go"
+)
+
+type param struct {
+	name     string
+	typ      ast.Expr
+	variadic bool
+}
+
+type method struct {
+	name    string
+	doc     string
+	params  []param
+	results []ast.Expr
+}
+
+// api is the set of methods of one receiver type
+type api struct {
+	recv    string // TransactionService or Client
+	field   string // Transaction, the Client field holding the service
+	name    string // TransactionAPI or API
+	methods []*method
+}
+
+// excluded are Client methods that configure the client rather than call the API
+var excluded = map[string]bool{"Use": true}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("apigen: ")
+
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(paths)
+
+	var files []*ast.File
+	imports := map[string]string{}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_gen.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			log.Fatal(err)
+		}
+		files = append(files, f)
+		for _, spec := range f.Imports {
+			p, _ := strconv.Unquote(spec.Path.Value)
+			name := p[strings.LastIndex(p, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = p
+		}
+	}
+
+	services := serviceFields(files)
+	apis := map[string]*api{"Client": {recv: "Client", name: "API"}}
+	var order []*api
+	for _, s := range services {
+		a := &api{recv: s[1], field: s[0], name: strings.TrimSuffix(s[1], "Service") + "API"}
+		apis[a.recv] = a
+		order = append(order, a)
+	}
+	order = append(order, apis["Client"])
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv, ok := star.X.(*ast.Ident)
+			if !ok || apis[recv.Name] == nil || (recv.Name == "Client" && excluded[fn.Name.Name]) {
+				continue
+			}
+			apis[recv.Name].methods = append(apis[recv.Name].methods, newMethod(fn))
+		}
+	}
+
+	write("api_gen.go", interfaces(order, imports))
+	write(filepath.Join("paystackmock", "mock_gen.go"), mocks(order, imports))
+}
+
+// serviceFields returns the name and service type of each service field of
+// Client, in declaration order
+func serviceFields(files []*ast.File) [][2]string {
+	var out [][2]string
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok || ts.Name.Name != "Client" {
+					continue
+				}
+				for _, field := range st.Fields.List {
+					star, ok := field.Type.(*ast.StarExpr)
+					if !ok {
+						continue
+					}
+					id, ok := star.X.(*ast.Ident)
+					if !ok || !strings.HasSuffix(id.Name, "Service") {
+						continue
+					}
+					for _, name := range field.Names {
+						out = append(out, [2]string{name.Name, id.Name})
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+func newMethod(fn *ast.FuncDecl) *method {
+	m := &method{name: fn.Name.Name}
+	if fn.Doc != nil {
+		// keep the first sentence, on one line, without the reference
+		// link most methods end their doc with
+		var lines []string
+		for _, line := range strings.Split(fn.Doc.Text(), "\n") {
+			if !strings.HasPrefix(line, "For more details") {
+				lines = append(lines, line)
+			}
+		}
+		doc := strings.Join(strings.Fields(strings.Join(lines, " ")), " ")
+		if i := strings.Index(doc, ". "); i >= 0 {
+			doc = doc[:i+1]
+		}
+		m.doc = doc
+	}
+	for i, field := range fn.Type.Params.List {
+		typ, variadic := field.Type, false
+		if e, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = e.Elt, true
+		}
+		if len(field.Names) == 0 {
+			m.params = append(m.params, param{fmt.Sprintf("p%d", i), typ, variadic})
+		}
+		for _, name := range field.Names {
+			m.params = append(m.params, param{name.Name, typ, variadic})
+		}
+	}
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.results = append(m.results, field.Type)
+			}
+		}
+	}
+	return m
+}
+
+// typeString renders a type expression. With qualify set, identifiers
+// exported by package paystack are prefixed with its name.
+func typeString(e ast.Expr, qualify bool, used map[string]bool) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if qualify && t.IsExported() {
+			used["paystack"] = true
+			return "paystack." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, qualify, used)
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		used[pkg] = true
+		return pkg + "." + t.Sel.Name
+	case *ast.ArrayType:
+		n := ""
+		if t.Len != nil {
+			n = t.Len.(*ast.BasicLit).Value
+		}
+		return "[" + n + "]" + typeString(t.Elt, qualify, used)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key, qualify, used) + "]" + typeString(t.Value, qualify, used)
+	case *ast.Ellipsis:
+		return "..." + typeString(t.Elt, qualify, used)
+	case *ast.IndexExpr:
+		return typeString(t.X, qualify, used) + "[" + typeString(t.Index, qualify, used) + "]"
+	case *ast.IndexListExpr:
+		args := make([]string, len(t.Indices))
+		for i, idx := range t.Indices {
+			args[i] = typeString(idx, qualify, used)
+		}
+		return typeString(t.X, qualify, used) + "[" + strings.Join(args, ", ") + "]"
+	case *ast.InterfaceType:
+		if t.Methods == nil || len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	case *ast.ChanType:
+		return "chan " + typeString(t.Value, qualify, used)
+	case *ast.FuncType:
+		return "func" + signature(&method{params: fieldParams(t.Params), results: fieldTypes(t.Results)}, qualify, used)
+	}
+	log.Fatalf("unsupported type %T", e)
+	return ""
+}
+
+func fieldParams(fl *ast.FieldList) []param {
+	var out []param
+	for i, t := range fieldTypes(fl) {
+		out = append(out, param{name: fmt.Sprintf("p%d", i), typ: t})
+	}
+	return out
+}
+
+func fieldTypes(fl *ast.FieldList) []ast.Expr {
+	if fl == nil {
+		return nil
+	}
+	var out []ast.Expr
+	for _, f := range fl.List {
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			out = append(out, f.Type)
+		}
+	}
+	return out
+}
+
+// signature renders the parameters and results of m
+func signature(m *method, qualify bool, used map[string]bool) string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		t := typeString(p.typ, qualify, used)
+		if p.variadic {
+			t = "..." + t
+		}
+		params[i] = p.name + " " + t
+	}
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		results[i] = typeString(r, qualify, used)
+	}
+	s := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		s += " " + results[0]
+	default:
+		s += " (" + strings.Join(results, ", ") + ")"
+	}
+	return s
+}
+
+func isContext(e ast.Expr) bool {
+	sel, ok := e.(*ast.SelectorExpr)
+	return ok && sel.X.(*ast.Ident).Name == "context" && sel.Sel.Name == "Context"
+}
+
+func isError(e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == "error"
+}
+
+// iteratorElem returns the element type of a *Iterator[T], or nil if e is
+// not an iterator
+func iteratorElem(e ast.Expr) ast.Expr {
+	star, ok := e.(*ast.StarExpr)
+	if !ok {
+		return nil
+	}
+	idx, ok := star.X.(*ast.IndexExpr)
+	if !ok {
+		return nil
+	}
+	if id, ok := idx.X.(*ast.Ident); !ok || id.Name != "Iterator" {
+		return nil
+	}
+	return idx.Index
+}
+
+func interfaces(apis []*api, imports map[string]string) []byte {
+	used := map[string]bool{}
+	var body bytes.Buffer
+	for _, a := range apis {
+		if a.recv == "Client" {
+			fmt.Fprintf(&body, "// API is implemented by Client. It bundles the service interfaces\n// with the calls made on Client directly.\n")
+		} else {
+			fmt.Fprintf(&body, "// %s is implemented by %s\n", a.name, a.recv)
+		}
+		fmt.Fprintf(&body, "type %s interface {\n", a.name)
+		for _, m := range a.methods {
+			if m.doc != "" {
+				fmt.Fprintf(&body, "// %s\n", m.doc)
+			}
+			fmt.Fprintf(&body, "%s%s\n", m.name, signature(m, false, used))
+		}
+		fmt.Fprintf(&body, "}\n\n")
+	}
+	fmt.Fprintf(&body, "var (\n")
+	for _, a := range apis {
+		fmt.Fprintf(&body, "_ %s = (*%s)(nil)\n", a.name, a.recv)
+	}
+	fmt.Fprintf(&body, ")\n")
+
+	var out bytes.Buffer
+	out.WriteString(header + "package paystack\n\n")
+	writeImports(&out, used, imports)
+	out.Write(body.Bytes())
+	return out.Bytes()
+}
+
+func mocks(apis []*api, imports map[string]string) []byte {
+	used := map[string]bool{}
+	var body bytes.Buffer
+	for _, a := range apis {
+		mockName := a.name
+		if a.recv == "Client" {
+			mockName = "Client"
+			fmt.Fprintf(&body, "// Client is a mock paystack.API whose services are mocks too.\n// Use NewClient to create one with every service set.\n")
+		} else {
+			fmt.Fprintf(&body, "// %s is a mock paystack.%s\n", mockName, a.name)
+		}
+		fmt.Fprintf(&body, "type %s struct {\nRecorder\n\n", mockName)
+		if a.recv == "Client" {
+			for _, s := range apis {
+				if s.recv != "Client" {
+					fmt.Fprintf(&body, "%s *%s\n", s.field, s.name)
+				}
+			}
+			fmt.Fprintln(&body)
+		}
+		for _, m := range a.methods {
+			if accessor(m, apis) != nil {
+				continue
+			}
+			fmt.Fprintf(&body, "%sFunc func%s\n", m.name, signature(m, true, used))
+		}
+		fmt.Fprintf(&body, "}\n\n")
+
+		methods := map[string]bool{}
+		for _, m := range a.methods {
+			methods[m.name] = true
+		}
+		for _, m := range a.methods {
+			writeMock(&body, mockName, m, methods, apis, used)
+		}
+	}
+
+	fmt.Fprintf(&body, "// NewClient returns a mock client with a mock for every service\nfunc NewClient() *Client {\nreturn &Client{\n")
+	for _, s := range apis {
+		if s.recv != "Client" {
+			fmt.Fprintf(&body, "%s: &%s{},\n", s.field, s.name)
+		}
+	}
+	fmt.Fprintf(&body, "}\n}\n\n")
+	fmt.Fprintf(&body, "var (\n")
+	for _, a := range apis {
+		if a.recv == "Client" {
+			fmt.Fprintf(&body, "_ paystack.API = (*Client)(nil)\n")
+		} else {
+			fmt.Fprintf(&body, "_ paystack.%s = (*%s)(nil)\n", a.name, a.name)
+		}
+	}
+	fmt.Fprintf(&body, ")\n")
+	used["paystack"] = true
+
+	var out bytes.Buffer
+	out.WriteString(header + "package paystackmock\n\n")
+	imports["paystack"] = modulePath
+	writeImports(&out, used, imports)
+	out.Write(body.Bytes())
+	return out.Bytes()
+}
+
+// accessor returns the service a Client method such as Transactions returns
+func accessor(m *method, apis []*api) *api {
+	if len(m.params) != 0 || len(m.results) != 1 {
+		return nil
+	}
+	id, ok := m.results[0].(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	for _, a := range apis {
+		if a.recv != "Client" && a.name == id.Name {
+			return a
+		}
+	}
+	return nil
+}
+
+func writeMock(w *bytes.Buffer, mockName string, m *method, methods map[string]bool, apis []*api, used map[string]bool) {
+	if s := accessor(m, apis); s != nil {
+		fmt.Fprintf(w, "// %s returns the %s mock\nfunc (m *%s) %s() paystack.%s {\nreturn m.%s\n}\n\n",
+			m.name, s.name, mockName, m.name, s.name, s.field)
+		return
+	}
+
+	var args, recorded []string
+	for _, p := range m.params {
+		a := p.name
+		if p.variadic {
+			a += "..."
+		}
+		args = append(args, a)
+		if !isContext(p.typ) {
+			recorded = append(recorded, p.name)
+		}
+	}
+	ret := ""
+	if len(m.results) > 0 {
+		ret = "return "
+	}
+
+	fmt.Fprintf(w, "// %s records the call and runs %sFunc", m.name, m.name)
+	withCtx := m.name + "WithContext"
+	hasCtxVariant := methods[withCtx] && (len(m.params) == 0 || !isContext(m.params[0].typ))
+	if hasCtxVariant {
+		fmt.Fprintf(w, ",\n// or %sFunc with a background context", withCtx)
+	}
+	fmt.Fprintf(w, "\nfunc (m *%s) %s%s {\n", mockName, m.name, signature(m, true, used))
+	fmt.Fprintf(w, "m.record(%q", m.name)
+	for _, r := range recorded {
+		fmt.Fprintf(w, ", %s", r)
+	}
+	fmt.Fprintf(w, ")\n")
+	fmt.Fprintf(w, "if m.%sFunc != nil {\n%sm.%sFunc(%s)\n", m.name, ret, m.name, strings.Join(args, ", "))
+	if len(m.results) == 0 {
+		fmt.Fprintf(w, "return\n")
+	}
+	fmt.Fprintf(w, "}\n")
+	if hasCtxVariant {
+		used["context"] = true
+		fmt.Fprintf(w, "if m.%sFunc != nil {\n%sm.%sFunc(%s)\n", withCtx, ret, withCtx,
+			strings.Join(append([]string{"context.Background()"}, args...), ", "))
+		if len(m.results) == 0 {
+			fmt.Fprintf(w, "return\n")
+		}
+		fmt.Fprintf(w, "}\n")
+	}
+	if len(m.results) > 0 {
+		zeros := make([]string, len(m.results))
+		for i, r := range m.results {
+			if i == len(m.results)-1 && isError(r) {
+				zeros[i] = fmt.Sprintf("notStubbed(%q)", mockName+"."+m.name)
+				continue
+			}
+			// a nil iterator would panic on Next, so return one that fails instead
+			if elem := iteratorElem(r); elem != nil && len(m.results) == 1 {
+				zeros[i] = fmt.Sprintf("paystack.ErrorIterator[%s](notStubbed(%q))",
+					typeString(elem, true, used), mockName+"."+m.name)
+				continue
+			}
+			fmt.Fprintf(w, "var r%d %s\n", i, typeString(r, true, used))
+			zeros[i] = fmt.Sprintf("r%d", i)
+		}
+		fmt.Fprintf(w, "return %s\n", strings.Join(zeros, ", "))
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+func writeImports(w *bytes.Buffer, used map[string]bool, imports map[string]string) {
+	var std, other []string
+	for name := range used {
+		path, ok := imports[name]
+		if !ok {
+			log.Fatalf("unknown package %s", name)
+		}
+		spec := strconv.Quote(path)
+		if path[strings.LastIndex(path, "/")+1:] != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(path, ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	w.WriteString("import (\n")
+	for _, s := range std {
+		w.WriteString(s + "\n")
+	}
+	if len(std) > 0 && len(other) > 0 {
+		w.WriteString("\n")
+	}
+	for _, s := range other {
+		w.WriteString(s + "\n")
+	}
+	w.WriteString(")\n\n")
+}
+
+func write(path string, src []byte) {
+	out, err := format.Source(src)
+	if err != nil {
+		os.WriteFile(path, src, 0644)
+		log.Fatalf("%s: %v", path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(path, out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,157 @@
+package paystack
+
+import (
+	"context"
+	"iter"
+)
+
+// defaultPerPage is the page size used by iterators when none is given
+const defaultPerPage = 50
+
+// ListOptions controls how an Iterator walks a paginated list
+type ListOptions struct {
+	// PerPage is the number of items fetched per request, 50 if unset. It
+	// is lowered to Limit when that is smaller.
+	PerPage int
+	// Limit caps the total number of items returned, 0 means no limit
+	Limit int
+}
+
+// pageFunc fetches one page of a list. Pages are numbered from 1.
+type pageFunc[T any] func(ctx context.Context, count, page int) ([]T, ListMeta, error)
+
+// Iterator walks every page of a list endpoint lazily, fetching the next
+// page only when the current one is exhausted. Typical use:
+//
+//	it := client.Transaction.ListAll(ctx, nil)
+//	for it.Next() {
+//		txn := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	fetch pageFunc[T]
+	opts  ListOptions
+
+	page int
+	buf  []T
+	cur  T
+	seen int
+	meta ListMeta
+	last bool
+	err  error
+}
+
+func newIterator[T any](ctx context.Context, opts *ListOptions, fetch pageFunc[T]) *Iterator[T] {
+	it := &Iterator[T]{ctx: ctx, fetch: fetch}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.PerPage <= 0 {
+		it.opts.PerPage = defaultPerPage
+	}
+	// don't fetch more than the limit asks for
+	if it.opts.Limit > 0 {
+		it.opts.PerPage = min(it.opts.PerPage, it.opts.Limit)
+	}
+	return it
+}
+
+// SliceIterator returns an Iterator over items that makes no requests. It is
+// meant for stubbing list methods in tests.
+func SliceIterator[T any](items []T) *Iterator[T] {
+	return newIterator(context.Background(), &ListOptions{PerPage: len(items) + 1},
+		func(ctx context.Context, count, page int) ([]T, ListMeta, error) {
+			if page > 1 {
+				return nil, ListMeta{}, nil
+			}
+			return items, ListMeta{Total: len(items), PerPage: count, Page: 1, PageCount: 1}, nil
+		})
+}
+
+// ErrorIterator returns an Iterator that yields no items and whose Err is
+// err. It is meant for stubbing list methods that fail in tests.
+func ErrorIterator[T any](err error) *Iterator[T] {
+	return &Iterator[T]{ctx: context.Background(), err: err}
+}
+
+// Next advances to the next item, fetching a new page if needed.
+// It returns false when the list is exhausted, the limit is reached,
+// the context is done or a request fails; check Err afterwards.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil || (it.opts.Limit > 0 && it.seen >= it.opts.Limit) {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	for len(it.buf) == 0 {
+		if it.last {
+			return false
+		}
+		if !it.nextPage() {
+			return false
+		}
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	it.seen++
+	return true
+}
+
+// nextPage fetches the following page into the buffer
+func (it *Iterator[T]) nextPage() bool {
+	it.page++
+	items, meta, err := it.fetch(it.ctx, it.opts.PerPage, it.page)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.meta = meta
+	it.buf = items
+	// Stop at the last page reported by Paystack, or on a short page
+	// when the endpoint does not report a page count.
+	if meta.PageCount > 0 {
+		it.last = it.page >= meta.PageCount
+	} else {
+		it.last = len(items) < it.opts.PerPage
+	}
+	if len(items) == 0 {
+		it.last = true
+	}
+	return true
+}
+
+// Value returns the current item
+func (it *Iterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Meta returns the pagination metadata of the most recently fetched page
+func (it *Iterator[T]) Meta() ListMeta {
+	return it.meta
+}
+
+// All returns the remaining items as an iter.Seq2 for use with range.
+// A failure is yielded once as the error of a final zero item.
+func (it *Iterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next() {
+			if !yield(it.cur, nil) {
+				return
+			}
+		}
+		if it.err != nil {
+			var zero T
+			yield(zero, it.err)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// pagedServer serves total items split into pages, recording the requests made
+func pagedServer(total int, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("perPage"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageCount := (total + perPage - 1) / perPage
+
+		var items []string
+		for id := (page-1)*perPage + 1; id <= total && id <= page*perPage; id++ {
+			items = append(items, fmt.Sprintf(`{"id":%d,"recipient_code":"RCP_%d"}`, id, id))
+		}
+		fmt.Fprintf(w, `{"status":true,"message":"ok","data":[%s],"meta":{"total":%d,"perPage":%d,"page":%d,"pageCount":%d}}`,
+			strings.Join(items, ","), total, perPage, page, pageCount)
+	}))
+}
+
+func TestIteratorWalksAllPages(t *testing.T) {
+	var requests int32
+	ts := pagedServer(7, &requests)
+	defer ts.Close()
+
+	it := newTestClient(ts.URL).Transaction.ListAll(context.Background(), &ListOptions{PerPage: 3})
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 7 || ids[0] != 1 || ids[6] != 7 {
+		t.Errorf("Unexpected ids %v", ids)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 page requests, got %d", requests)
+	}
+	if it.Meta().PageCount != 3 {
+		t.Errorf("Expected page count 3, got %d", it.Meta().PageCount)
+	}
+}
+
+func TestIteratorLimit(t *testing.T) {
+	var requests int32
+	ts := pagedServer(100, &requests)
+	defer ts.Close()
+
+	it := newTestClient(ts.URL).Transfer.ListRecipientsAll(context.Background(), &ListOptions{PerPage: 4, Limit: 6})
+	var codes []string
+	for rcp, err := range it.All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		codes = append(codes, rcp.RecipientCode)
+	}
+	if len(codes) != 6 || codes[5] != "RCP_6" {
+		t.Errorf("Unexpected recipients %v", codes)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 page requests, got %d", requests)
+	}
+}
+
+func TestIteratorLimitBelowPerPage(t *testing.T) {
+	var requests int32
+	ts := pagedServer(100, &requests)
+	defer ts.Close()
+
+	it := newTestClient(ts.URL).Transaction.ListAll(context.Background(), &ListOptions{Limit: 5})
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 5 || requests != 1 {
+		t.Errorf("Expected 5 items from 1 request, got %v from %d", ids, requests)
+	}
+	if it.Meta().PerPage != 5 {
+		t.Errorf("Expected pages of 5 to be requested, got %d", it.Meta().PerPage)
+	}
+}
+
+func TestIteratorContextCancel(t *testing.T) {
+	var requests int32
+	ts := pagedServer(10, &requests)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	it := newTestClient(ts.URL).Customer.ListAll(ctx, &ListOptions{PerPage: 2})
+	n := 0
+	for it.Next() {
+		if n++; n == 3 {
+			cancel()
+		}
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", it.Err())
+	}
+	if n != 3 {
+		t.Errorf("Expected iteration to stop after 3 items, got %d", n)
+	}
+}
+
+func TestIteratorError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"status":false,"message":"Invalid key"}`))
+	}))
+	defer ts.Close()
+
+	yielded := 0
+	for _, err := range newTestClient(ts.URL).Plan.ListAll(context.Background(), nil).All() {
+		yielded++
+		if !errors.Is(err, ErrAuthentication) {
+			t.Errorf("Expected ErrAuthentication, got %v", err)
+		}
+	}
+	if yielded != 1 {
+		t.Errorf("Expected the error to be yielded once, got %d", yielded)
+	}
+}
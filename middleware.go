@@ -0,0 +1,54 @@
+package paystack
+
+import (
+	"context"
+	"net/http"
+)
+
+// Operation describes a single API call as it passes through middleware.
+// Middleware may add request headers before calling the next handler and
+// inspect the decoded Result and StatusCode after it returns.
+type Operation struct {
+	// Name identifies the service call, such as "Transaction.Initialize".
+	// Calls made directly through Client.Call are named "METHOD path".
+	Name   string
+	Method string
+	Path   string
+
+	// Header holds extra headers sent with every attempt of the request
+	Header http.Header
+
+	// Body is the request body before JSON encoding, nil for GET requests
+	Body interface{}
+
+	// Result is the value the response is decoded into
+	Result interface{}
+
+	// StatusCode is the HTTP status of the final attempt, 0 if none completed
+	StatusCode int
+
+	// Response describes the response to the final attempt, nil if none completed
+	Response *ResponseInfo
+}
+
+// RoundTripFunc performs an API operation
+type RoundTripFunc func(ctx context.Context, op *Operation) error
+
+// Middleware wraps a RoundTripFunc to observe or alter every API call made
+// by the client, e.g. for tracing, metrics, auditing or request signing.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends middleware to the client. The first middleware registered is
+// the outermost one. Use is not safe to call concurrently with API calls.
+func (c *Client) Use(mw ...Middleware) {
+	c.middleware = append(c.middleware, mw...)
+}
+
+// chain composes the registered middleware around send
+func (c *Client) chain() RoundTripFunc {
+	h := RoundTripFunc(c.send)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+	return h
+}
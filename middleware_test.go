@@ -0,0 +1,96 @@
+package paystack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	var gotTrace string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTrace = r.Header.Get("X-Trace-Id")
+		w.Write([]byte(`{"status":true,"message":"ok","data":{"id":7,"reference":"ref-7"}}`))
+	}))
+	defer ts.Close()
+
+	var order []string
+	var seen *Operation
+	tracing := func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, op *Operation) error {
+			order = append(order, "tracing")
+			op.Header.Set("X-Trace-Id", "trace-1")
+			return next(ctx, op)
+		}
+	}
+	audit := func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, op *Operation) error {
+			order = append(order, "audit")
+			err := next(ctx, op)
+			seen = op
+			return err
+		}
+	}
+
+	client := newTestClient(ts.URL)
+	client.Use(tracing, audit)
+
+	if _, err := client.Transaction.Verify("ref-7"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(order) != 2 || order[0] != "tracing" || order[1] != "audit" {
+		t.Errorf("Unexpected middleware order %v", order)
+	}
+	if gotTrace != "trace-1" {
+		t.Errorf("Expected trace header to reach the server, got %q", gotTrace)
+	}
+	if seen.Name != "Transaction.Verify" || seen.StatusCode != http.StatusOK || seen.Response.Message != "ok" {
+		t.Errorf("Unexpected operation %+v", seen)
+	}
+	if txn, ok := seen.Result.(*Transaction); !ok || txn.ID != 7 {
+		t.Errorf("Expected decoded transaction in result, got %+v", seen.Result)
+	}
+}
+
+func TestMiddlewareSeesTypedResultWithRaw(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":true,"message":"ok","data":{"authorization_url":"https://checkout.paystack.com/abc","access_code":"abc","reference":"ref-1"}}`))
+	}))
+	defer ts.Close()
+
+	var result interface{}
+	client := newTestClient(ts.URL)
+	client.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, op *Operation) error {
+			err := next(ctx, op)
+			result = op.Result
+			return err
+		}
+	})
+
+	res, err := client.Transaction.Initialize(&TransactionRequest{Email: "a@b.co", Amount: 10000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := result.(*InitializeResult); !ok || got.AccessCode != "abc" {
+		t.Errorf("Expected decoded result in middleware, got %#v", result)
+	}
+	if res.Raw["access_code"] != "abc" || res.Reference != "ref-1" {
+		t.Errorf("Expected typed fields and raw payload, got %+v", res)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	client := newTestClient("http://127.0.0.1:0")
+	client.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, op *Operation) error {
+			return context.Canceled
+		}
+	})
+
+	if _, err := client.Plan.Get(1); err != context.Canceled {
+		t.Errorf("Expected middleware error, got %v", err)
+	}
+}
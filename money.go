@@ -0,0 +1,197 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Amount is a sum of money in the minor unit of its currency, such as kobo,
+// pesewas or cents. This is the form Paystack expects and returns amounts in,
+// so an Amount of 50000 in NGN is ₦500.00.
+type Amount int64
+
+// minorUnitExponent is the number of decimal places between the major and
+// minor unit assumed for an Amount without a currency. Every currency
+// Paystack supports uses 2, see CurrencyInfo.Exponent.
+const minorUnitExponent = 2
+
+// AmountFromMajor converts an amount in major units (naira, cedis, rand…)
+// to minor units, rounding to the nearest minor unit. Prefer ParseAmount
+// when the amount is available as text, as it avoids floating point entirely.
+func AmountFromMajor(major float64) Amount {
+	return Amount(math.Round(major * math.Pow10(minorUnitExponent)))
+}
+
+// ParseAmount parses a decimal amount in major units, such as "1250.50",
+// into minor units exactly. Commas used as thousands separators are ignored.
+func ParseAmount(s string) (Amount, error) {
+	return parseAmount(s, minorUnitExponent)
+}
+
+func parseAmount(s string, exp int) (Amount, error) {
+	orig := s
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if whole == "" && frac == "" || len(frac) > exp {
+		return 0, fmt.Errorf("paystack: invalid amount %q", orig)
+	}
+	frac += strings.Repeat("0", exp-len(frac))
+
+	n, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("paystack: invalid amount %q", orig)
+	}
+	if neg {
+		n = -n
+	}
+	return Amount(n), nil
+}
+
+// Major returns the amount in major units. The result is approximate for
+// amounts that a float64 cannot represent exactly and is meant for display.
+func (a Amount) Major() float64 {
+	return float64(a) / math.Pow10(minorUnitExponent)
+}
+
+// String formats the amount in major units with thousands separators, e.g. "1,250.50"
+func (a Amount) String() string {
+	return formatMinor(int64(a), minorUnitExponent)
+}
+
+// UnmarshalJSON accepts amounts encoded as JSON numbers or numeric strings,
+// both of which occur in Paystack responses
+func (a *Amount) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" {
+		*a = 0
+		return nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*a = Amount(n)
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != math.Trunc(f) {
+		return fmt.Errorf("paystack: invalid amount %s", b)
+	}
+	*a = Amount(f)
+	return nil
+}
+
+// formatMinor renders n minor units as a major unit decimal with exp places
+func formatMinor(n int64, exp int) string {
+	sign := ""
+	u := uint64(n)
+	if n < 0 {
+		sign = "-"
+		u = uint64(-n)
+	}
+	digits := strconv.FormatUint(u, 10)
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-exp], digits[len(digits)-exp:]
+
+	var b strings.Builder
+	for i, d := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(d)
+	}
+	if exp > 0 {
+		b.WriteByte('.')
+		b.WriteString(frac)
+	}
+	return sign + b.String()
+}
+
+// ErrCurrencyMismatch is returned when combining Money in different currencies
+var ErrCurrencyMismatch = errors.New("paystack: currency mismatch")
+
+// Money is an Amount together with its currency
+type Money struct {
+	Amount   Amount   `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// NewMoney returns Money for an amount in minor units
+func NewMoney(amount Amount, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// MoneyFromMajor returns Money for an amount in major units, see AmountFromMajor.
+// The currency's minor unit exponent is used for the conversion.
+func MoneyFromMajor(major float64, currency Currency) Money {
+	a := Amount(math.Round(major * math.Pow10(currency.exponent())))
+	return Money{Amount: a, Currency: currency}
+}
+
+// ParseMoney parses a decimal amount in major units of currency, see ParseAmount
+func ParseMoney(s string, currency Currency) (Money, error) {
+	a, err := parseAmount(s, currency.exponent())
+	return Money{Amount: a, Currency: currency}, err
+}
+
+// Add returns m + o. Both must be in the same currency.
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: m.Currency}, nil
+}
+
+// Sub returns m - o. Both must be in the same currency.
+func (m Money) Sub(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount - o.Amount, Currency: m.Currency}, nil
+}
+
+// Mul returns m multiplied by n, e.g. a unit price times a quantity
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * Amount(n), Currency: m.Currency}
+}
+
+// Percent returns p percent of m, rounded half away from zero to the minor unit.
+// It suits fees and splits expressed as percentages, such as 1.5.
+func (m Money) Percent(p float64) Money {
+	return Money{Amount: Amount(math.Round(float64(m.Amount) * p / 100)), Currency: m.Currency}
+}
+
+// IsZero reports whether m has no value
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Cmp compares m and o, returning -1, 0 or +1. Both must be in the same currency.
+func (m Money) Cmp(o Money) (int, error) {
+	if m.Currency != o.Currency {
+		return 0, ErrCurrencyMismatch
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// String formats m for display, e.g. "NGN 1,250.50"
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount.String()
+	}
+	return string(m.Currency) + " " + formatMinor(int64(m.Amount), m.Currency.exponent())
+}
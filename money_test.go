@@ -0,0 +1,109 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	cases := map[string]Amount{
+		"1250.50":           125050,
+		"1,250.5":           125050,
+		"0.07":              7,
+		"-3":                -300,
+		"92233720368547758": 9223372036854775800,
+		".5":                50,
+	}
+	for in, want := range cases {
+		got, err := ParseAmount(in)
+		if err != nil || got != want {
+			t.Errorf("ParseAmount(%q) = %v, %v; want %d", in, int64(got), err, want)
+		}
+	}
+
+	for _, in := range []string{"", "1.234", "abc", "1e5"} {
+		if _, err := ParseAmount(in); err == nil {
+			t.Errorf("ParseAmount(%q) expected error", in)
+		}
+	}
+}
+
+func TestAmountFormatting(t *testing.T) {
+	cases := map[Amount]string{
+		0:          "0.00",
+		5:          "0.05",
+		125050:     "1,250.50",
+		-100000000: "-1,000,000.00",
+	}
+	for in, want := range cases {
+		if got := in.String(); got != want {
+			t.Errorf("Amount(%d).String() = %q, want %q", int64(in), got, want)
+		}
+	}
+
+	if got := AmountFromMajor(19.99); got != 1999 {
+		t.Errorf("AmountFromMajor(19.99) = %d, want 1999", got)
+	}
+	if got := NewMoney(125050, "NGN").String(); got != "NGN 1,250.50" {
+		t.Errorf("Unexpected money format %q", got)
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	price := NewMoney(2500, "GHS")
+	total := price.Mul(3)
+
+	sum, err := total.Add(NewMoney(500, "GHS"))
+	if err != nil || sum.Amount != 8000 {
+		t.Errorf("Expected 8000, got %v, %v", sum.Amount, err)
+	}
+	if fee := sum.Percent(1.5); fee.Amount != 120 {
+		t.Errorf("Expected 1.5%% fee of 120, got %d", fee.Amount)
+	}
+	if _, err := sum.Sub(NewMoney(1, "NGN")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected currency mismatch, got %v", err)
+	}
+	if cmp, _ := price.Cmp(total); cmp != -1 {
+		t.Errorf("Expected price < total")
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	var v struct {
+		A Amount `json:"a"`
+		B Amount `json:"b"`
+		C Amount `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":9007199254740993,"b":"5000","c":300.0}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.A != 9007199254740993 || v.B != 5000 || v.C != 300 {
+		t.Errorf("Unexpected amounts %+v", v)
+	}
+	if err := json.Unmarshal([]byte(`{"a":1.5}`), &v); err == nil {
+		t.Error("Expected fractional minor units to be rejected")
+	}
+
+	b, _ := json.Marshal(TransferRequest{Amount: 123456789})
+	if string(b) != `{"amount":123456789}` {
+		t.Errorf("Unexpected encoding %s", b)
+	}
+}
+
+func TestAmountDecodeResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":true,"message":"ok","data":{"amount":"250000","fees":3750,"currency":"NGN"}}`))
+	}))
+	defer ts.Close()
+
+	txn, err := newTestClient(ts.URL).Transaction.Verify("ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txn.Amount != 250000 || txn.Fees != 3750 {
+		t.Errorf("Unexpected amounts %d, %d", txn.Amount, txn.Fees)
+	}
+}
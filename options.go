@@ -0,0 +1,123 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a Client created with NewClientWithOptions
+type Option func(*Client) error
+
+// WithBaseURL points the client at a different API host, such as a local
+// stand-in server. A path prefix on the URL is kept for every request.
+func WithBaseURL(rawurl string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(rawurl)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("paystack: base URL %q must be absolute", rawurl)
+		}
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.baseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent replaces the User-Agent header sent with every request
+func WithUserAgent(ua string) Option {
+	return func(c *Client) error {
+		c.userAgent = ua
+		return nil
+	}
+}
+
+// WithAppInfo identifies the application using the library by appending
+// "name/version (url)" to the User-Agent header
+func WithAppInfo(name, version, appURL string) Option {
+	return func(c *Client) error {
+		if name == "" {
+			return errors.New("paystack: app name is required")
+		}
+		info := name
+		if version != "" {
+			info += "/" + version
+		}
+		if appURL != "" {
+			info += " (" + appURL + ")"
+		}
+		c.userAgent += " " + info
+		return nil
+	}
+}
+
+// WithLogger enables logging to logger. A nil logger disables logging.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) error {
+		c.Log = logger
+		c.LoggingEnabled = logger != nil
+		return nil
+	}
+}
+
+// WithRetryPolicy replaces the default retry policy. A nil policy disables retries.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) error {
+		c.RetryPolicy = policy
+		return nil
+	}
+}
+
+// WithHTTPClient makes the client send requests through httpClient.
+// A nil httpClient keeps the default one.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient != nil {
+			c.client = httpClient
+		}
+		return nil
+	}
+}
+
+// WithTimeout sets the overall timeout of each HTTP attempt
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		hc := *c.client
+		hc.Timeout = d
+		c.client = &hc
+		return nil
+	}
+}
+
+// WithTransport sets the round tripper used for HTTP requests
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) error {
+		hc := *c.client
+		hc.Transport = rt
+		c.client = &hc
+		return nil
+	}
+}
+
+// WithDefaultCurrency sets the currency used by requests that leave theirs empty
+func WithDefaultCurrency(currency Currency) Option {
+	return func(c *Client) error {
+		c.defaultCurrency = currency
+		return nil
+	}
+}
+
+// WithMiddleware registers middleware on the client, see Client.Use
+func WithMiddleware(mw ...Middleware) Option {
+	return func(c *Client) error {
+		c.Use(mw...)
+		return nil
+	}
+}
@@ -0,0 +1,71 @@
+package paystack
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	var gotPath, gotUA string
+	var gotBody map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotUA = r.Header.Get("User-Agent")
+		json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Write([]byte(`{"status":true,"message":"ok","data":{"id":1}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClientWithOptions("sk_test_key",
+		WithBaseURL(ts.URL+"/paystack"),
+		WithAppInfo("shop", "1.2.0", "https://shop.example"),
+		WithDefaultCurrency("GHS"),
+		WithTimeout(5*time.Second),
+		WithRetryPolicy(nil),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &TransactionRequest{Email: "user@example.com", Amount: 1000}
+	if _, err := client.Transaction.Initialize(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotPath != "/paystack/transaction/initialize" {
+		t.Errorf("Expected base URL path prefix to be kept, got %v", gotPath)
+	}
+	if !strings.HasPrefix(gotUA, userAgent) || !strings.HasSuffix(gotUA, "shop/1.2.0 (https://shop.example)") {
+		t.Errorf("Unexpected user agent %q", gotUA)
+	}
+	if gotBody["currency"] != "GHS" {
+		t.Errorf("Expected default currency GHS, got %v", gotBody["currency"])
+	}
+	if req.Currency != "" {
+		t.Errorf("Expected caller's request to be left untouched, got %v", req.Currency)
+	}
+	if client.client.Timeout != 5*time.Second {
+		t.Errorf("Expected 5s timeout, got %v", client.client.Timeout)
+	}
+	if client.RetryPolicy != nil || client.LoggingEnabled {
+		t.Error("Expected retries and logging to be disabled")
+	}
+}
+
+func TestWithBaseURLRejectsRelative(t *testing.T) {
+	if _, err := NewClientWithOptions("sk_test_key", WithBaseURL("/v1")); err == nil {
+		t.Error("Expected error for relative base URL")
+	}
+}
+
+func TestNewClientCompat(t *testing.T) {
+	hc := &http.Client{}
+	client := NewClient("sk_test_key", hc)
+	if client.client != hc || client.userAgent != legacyUserAgent || !client.LoggingEnabled {
+		t.Error("Expected NewClient to keep its previous defaults")
+	}
+}
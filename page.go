@@ -1,6 +1,9 @@
 package paystack
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // PageService handles operations related to the page
 // For more details see https://developers.paystack.co/v1.0/reference#create-page
@@ -17,11 +20,13 @@ type Page struct {
 	Name         string              `json:"name,omitempty"`
 	Slug         string              `json:"slug,omitempty"`
 	Description  string              `json:"description,omitempty"`
-	Amount       float32             `json:"amount,omitempty"`
-	Currency     string              `json:"currency,omitempty"`
+	Amount       Amount              `json:"amount,omitempty"`
+	Currency     Currency            `json:"currency,omitempty"`
 	Active       bool                `json:"active,omitempty"`
 	RedirectURL  string              `json:"redirect_url,omitempty"`
 	CustomFields []map[string]string `json:"custom_fields,omitempty"`
+	// Products are the products sold on the page, see AddProducts
+	Products []Product `json:"products,omitempty"`
 }
 
 // PageList is a list object for pages.
@@ -33,9 +38,22 @@ type PageList struct {
 // Create creates a new page
 // For more details see https://developers.paystack.co/v1.0/reference#create-page
 func (s *PageService) Create(page *Page) (*Page, error) {
+	return s.CreateWithContext(context.Background(), page)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *PageService) CreateWithContext(ctx context.Context, page *Page) (*Page, error) {
+	if page == nil {
+		return nil, newRequestValidationError("request", "page is required")
+	}
 	u := fmt.Sprintf("/page")
+	req := *page
+	req.Currency = s.client.currency(req.Currency)
+	if err := checkCurrency(req.Currency, req.Amount, CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
 	pg := &Page{}
-	err := s.client.Call("POST", u, page, pg)
+	err := s.client.call(ctx, "Page.Create", "POST", u, &req, pg)
 
 	return pg, err
 }
@@ -43,19 +61,47 @@ func (s *PageService) Create(page *Page) (*Page, error) {
 // Update updates a page's properties.
 // For more details see https://developers.paystack.co/v1.0/reference#update-page
 func (s *PageService) Update(page *Page) (*Page, error) {
+	return s.UpdateWithContext(context.Background(), page)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the request
+func (s *PageService) UpdateWithContext(ctx context.Context, page *Page) (*Page, error) {
 	u := fmt.Sprintf("page/%d", page.ID)
 	pg := &Page{}
-	err := s.client.Call("PUT", u, page, pg)
+	err := s.client.call(ctx, "Page.Update", "PUT", u, page, pg)
+
+	return pg, err
+}
+
+// AddProducts adds products to a page, by their IDs
+// For more details see https://developers.paystack.co/v1.0/reference#add-products
+func (s *PageService) AddProducts(pageID int, productIDs ...int) (*Page, error) {
+	return s.AddProductsWithContext(context.Background(), pageID, productIDs...)
+}
 
+// AddProductsWithContext is like AddProducts but carries ctx through to the request
+func (s *PageService) AddProductsWithContext(ctx context.Context, pageID int, productIDs ...int) (*Page, error) {
+	if len(productIDs) == 0 {
+		return nil, newRequestValidationError("product", "at least one product is required")
+	}
+	u := fmt.Sprintf("/page/%d/product", pageID)
+	body := map[string][]int{"product": productIDs}
+	pg := &Page{}
+	err := s.client.call(ctx, "Page.AddProducts", "POST", u, body, pg)
 	return pg, err
 }
 
 // Get returns the details of a page.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-page
 func (s *PageService) Get(id int) (*Page, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *PageService) GetWithContext(ctx context.Context, id int) (*Page, error) {
 	u := fmt.Sprintf("/page/%d", id)
 	pg := &Page{}
-	err := s.client.Call("GET", u, nil, pg)
+	err := s.client.call(ctx, "Page.Get", "GET", u, nil, pg)
 
 	return pg, err
 }
@@ -63,14 +109,32 @@ func (s *PageService) Get(id int) (*Page, error) {
 // List returns a list of pages.
 // For more details see https://developers.paystack.co/v1.0/reference#list-pages
 func (s *PageService) List() (*PageList, error) {
-	return s.ListN(10, 0)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *PageService) ListWithContext(ctx context.Context) (*PageList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of pages
 // For more details see https://developers.paystack.co/v1.0/reference#list-pages
 func (s *PageService) ListN(count, offset int) (*PageList, error) {
-	u := paginateURL("/page", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *PageService) ListNWithContext(ctx context.Context, count, offset int) (*PageList, error) {
+	u := paginateURL("/page", count, offset, nil)
 	pg := &PageList{}
-	err := s.client.Call("GET", u, nil, pg)
+	err := s.client.call(ctx, "Page.ListN", "GET", u, nil, pg)
 	return pg, err
 }
+
+// ListAll returns an iterator over all pages, fetching pages as needed
+func (s *PageService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Page] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Page, ListMeta, error) {
+		list, err := s.ListNWithContext(ctx, count, page)
+		return list.Values, list.Meta, err
+	})
+}
@@ -1,6 +1,9 @@
 package paystack
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestPageCRUD(t *testing.T) {
 	page1 := &Page{
@@ -30,3 +33,9 @@ func TestPageCRUD(t *testing.T) {
 		t.Errorf("Expected Page list, got %d, returned error %v", len(pages.Values), err)
 	}
 }
+
+func TestPageCreateNilRequest(t *testing.T) {
+	if _, err := c.Page.Create(nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil page to fail validation, got %v", err)
+	}
+}
@@ -0,0 +1,360 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// PaymentRequestService handles operations related to payment requests,
+// the invoices Paystack sends customers on your behalf
+// For more details see https://developers.paystack.co/v1.0/reference#create-payment-request
+type PaymentRequestService service
+
+// Payment request statuses
+const (
+	PaymentRequestDraft   = "draft"
+	PaymentRequestPending = "pending"
+	PaymentRequestSuccess = "success"
+)
+
+// PaymentRequest represents an invoice issued to a customer
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-payment-request
+type PaymentRequest struct {
+	ID          int        `json:"id,omitempty"`
+	CreatedAt   string     `json:"created_at,omitempty"`
+	UpdatedAt   string     `json:"updated_at,omitempty"`
+	Domain      string     `json:"domain,omitempty"`
+	Integration int        `json:"integration,omitempty"`
+	RequestCode string     `json:"request_code,omitempty"`
+	Amount      Amount     `json:"amount,omitempty"`
+	Currency    Currency   `json:"currency,omitempty"`
+	DueDate     string     `json:"due_date,omitempty"`
+	Description string     `json:"description,omitempty"`
+	LineItems   []LineItem `json:"line_items,omitempty"`
+	Tax         []Tax      `json:"tax,omitempty"`
+	// Status is one of PaymentRequestDraft, PaymentRequestPending or PaymentRequestSuccess
+	Status        string                       `json:"status,omitempty"`
+	Paid          bool                         `json:"paid,omitempty"`
+	PaidAt        string                       `json:"paid_at,omitempty"`
+	Archived      bool                         `json:"archived,omitempty"`
+	HasInvoice    bool                         `json:"has_invoice,omitempty"`
+	InvoiceNumber int                          `json:"invoice_number,omitempty"`
+	PDFURL        string                       `json:"pdf_url,omitempty"`
+	Metadata      Metadata                     `json:"metadata,omitempty"`
+	Notifications []PaymentRequestNotification `json:"notifications,omitempty"`
+	Customer      Customer                     `json:"customer,omitempty"`
+	SplitCode     string                       `json:"split_code,omitempty"`
+	// PendingAmount is the amount still to be paid, returned by Verify
+	PendingAmount Amount `json:"pending_amount,omitempty"`
+}
+
+// LineItem is a product or service billed on a payment request
+type LineItem struct {
+	Name string `json:"name"`
+	// Amount is the price of one unit
+	Amount Amount `json:"amount"`
+	// Quantity defaults to 1
+	Quantity int `json:"quantity,omitempty"`
+}
+
+// Total returns the amount for all units of the item
+func (li LineItem) Total() Amount {
+	if li.Quantity == 0 {
+		return li.Amount
+	}
+	return li.Amount * Amount(li.Quantity)
+}
+
+// Tax is a tax charged on a payment request
+type Tax struct {
+	Name   string `json:"name"`
+	Amount Amount `json:"amount"`
+}
+
+// PaymentRequestNotification records a payment request being sent to the customer
+type PaymentRequestNotification struct {
+	SentAt  string `json:"sent_at,omitempty"`
+	Channel string `json:"channel,omitempty"`
+}
+
+// PaymentRequestRequest represents a request to create or update a payment request
+type PaymentRequestRequest struct {
+	// Customer is the ID or code of the customer to bill. It is required
+	// by Create and left unchanged by Update when empty.
+	Customer string `json:"customer,omitempty"`
+	// Amount is required unless LineItems are given, in which case it
+	// defaults to their total plus tax
+	Amount   Amount   `json:"amount,omitempty"`
+	Currency Currency `json:"currency,omitempty"`
+	// DueDate is an ISO 8601 date, e.g. 2024-06-30
+	DueDate     string     `json:"due_date,omitempty"`
+	Description string     `json:"description,omitempty"`
+	LineItems   []LineItem `json:"line_items,omitempty"`
+	Tax         []Tax      `json:"tax,omitempty"`
+	// SendNotification defaults to true; set it to false to keep Paystack
+	// from emailing the customer
+	SendNotification *bool `json:"send_notification,omitempty"`
+	// Draft saves the payment request without sending it, see Finalize
+	Draft         bool   `json:"draft,omitempty"`
+	HasInvoice    bool   `json:"has_invoice,omitempty"`
+	InvoiceNumber int    `json:"invoice_number,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+}
+
+// Total returns the amount the customer will be billed: Amount if set,
+// otherwise the total of the line items and tax
+func (r *PaymentRequestRequest) Total() Amount {
+	if r.Amount != 0 {
+		return r.Amount
+	}
+	var total Amount
+	for _, li := range r.LineItems {
+		total += li.Total()
+	}
+	for _, t := range r.Tax {
+		total += t.Amount
+	}
+	return total
+}
+
+// PaymentRequestList is a list object for payment requests.
+type PaymentRequestList struct {
+	Meta   ListMeta
+	Values []PaymentRequest `json:"data,omitempty"`
+}
+
+// PaymentRequestListParams filters the payment requests returned by
+// ListNWithParams and ListAllWithParams
+type PaymentRequestListParams struct {
+	// Customer is the ID of the customer billed
+	Customer int
+	// Status is one of the PaymentRequest statuses, such as PaymentRequestPending
+	Status   string
+	Currency Currency
+	// IncludeArchive includes archived payment requests
+	IncludeArchive bool
+	From           time.Time
+	To             time.Time
+}
+
+func (p *PaymentRequestListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	setInt(q, "customer", int64(p.Customer))
+	if p.Status != "" {
+		q.Set("status", p.Status)
+	}
+	if p.Currency != "" {
+		q.Set("currency", string(p.Currency))
+	}
+	if p.IncludeArchive {
+		q.Set("include_archive", strconv.FormatBool(p.IncludeArchive))
+	}
+	setTime(q, "from", p.From)
+	setTime(q, "to", p.To)
+	return q
+}
+
+// PaymentRequestTotals is the total amount of payment requests by status
+type PaymentRequestTotals struct {
+	Pending    []CurrencyAmount `json:"pending,omitempty"`
+	Successful []CurrencyAmount `json:"successful,omitempty"`
+	Total      []CurrencyAmount `json:"total,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// Create creates a payment request and, unless it is a draft, sends it to the customer
+// For more details see https://developers.paystack.co/v1.0/reference#create-payment-request
+func (s *PaymentRequestService) Create(req *PaymentRequestRequest) (*PaymentRequest, error) {
+	return s.CreateWithContext(context.Background(), req)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *PaymentRequestService) CreateWithContext(ctx context.Context, req *PaymentRequestRequest) (*PaymentRequest, error) {
+	if req == nil {
+		return nil, newRequestValidationError("request", "payment request is required")
+	}
+	if req.Customer == "" {
+		return nil, newRequestValidationError("customer", "customer is required")
+	}
+	if req.Amount == 0 && len(req.LineItems) == 0 {
+		return nil, newRequestValidationError("amount", "amount or line items are required")
+	}
+	body := *req
+	body.Currency = s.client.currency(body.Currency)
+	if err := checkCurrency(body.Currency, body.Total(), CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
+	return s.request(ctx, "PaymentRequest.Create", "POST", "/paymentrequest", &body)
+}
+
+// Update updates a payment request that has not been paid
+// For more details see https://developers.paystack.co/v1.0/reference#update-payment-request
+func (s *PaymentRequestService) Update(idOrCode string, req *PaymentRequestRequest) (*PaymentRequest, error) {
+	return s.UpdateWithContext(context.Background(), idOrCode, req)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the request.
+// Unlike Create, an empty currency is left out rather than set to the
+// client's default, so the payment request keeps its currency. The amount
+// is only checked against a currency that is given.
+func (s *PaymentRequestService) UpdateWithContext(ctx context.Context, idOrCode string, req *PaymentRequestRequest) (*PaymentRequest, error) {
+	if req == nil {
+		return nil, newRequestValidationError("request", "payment request is required")
+	}
+	if err := checkCurrency(req.Currency, req.Total(), CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("/paymentrequest/%s", idOrCode)
+	return s.request(ctx, "PaymentRequest.Update", "PUT", u, req)
+}
+
+// Get returns the details of a payment request.
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-payment-request
+func (s *PaymentRequestService) Get(idOrCode string) (*PaymentRequest, error) {
+	return s.GetWithContext(context.Background(), idOrCode)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *PaymentRequestService) GetWithContext(ctx context.Context, idOrCode string) (*PaymentRequest, error) {
+	u := fmt.Sprintf("/paymentrequest/%s", idOrCode)
+	return s.request(ctx, "PaymentRequest.Get", "GET", u, nil)
+}
+
+// Verify returns a payment request along with the amount still to be paid
+// For more details see https://developers.paystack.co/v1.0/reference#verify-payment-request
+func (s *PaymentRequestService) Verify(code string) (*PaymentRequest, error) {
+	return s.VerifyWithContext(context.Background(), code)
+}
+
+// VerifyWithContext is like Verify but carries ctx through to the request
+func (s *PaymentRequestService) VerifyWithContext(ctx context.Context, code string) (*PaymentRequest, error) {
+	u := fmt.Sprintf("/paymentrequest/verify/%s", code)
+	return s.request(ctx, "PaymentRequest.Verify", "GET", u, nil)
+}
+
+// request sends a call answered with a single payment request. Create and
+// Update return the customer's ID in place of the customer, which is
+// decoded into Customer.ID.
+func (s *PaymentRequestService) request(ctx context.Context, name, method, path string, body interface{}) (*PaymentRequest, error) {
+	resp := Response{}
+	if err := s.client.call(ctx, name, method, path, body, &resp); err != nil {
+		return nil, err
+	}
+	if id, ok := resp["customer"].(float64); ok {
+		resp["customer"] = map[string]interface{}{"id": id}
+	}
+	pr := &PaymentRequest{}
+	err := mapstruct(resp, pr)
+	return pr, err
+}
+
+// List returns a list of payment requests.
+// For more details see https://developers.paystack.co/v1.0/reference#list-payment-request
+func (s *PaymentRequestService) List() (*PaymentRequestList, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *PaymentRequestService) ListWithContext(ctx context.Context) (*PaymentRequestList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
+}
+
+// ListN returns a list of payment requests
+// For more details see https://developers.paystack.co/v1.0/reference#list-payment-request
+func (s *PaymentRequestService) ListN(count, offset int) (*PaymentRequestList, error) {
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *PaymentRequestService) ListNWithContext(ctx context.Context, count, offset int) (*PaymentRequestList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of payment requests matching params
+func (s *PaymentRequestService) ListNWithParams(count, offset int, params *PaymentRequestListParams) (*PaymentRequestList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *PaymentRequestService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *PaymentRequestListParams) (*PaymentRequestList, error) {
+	u := paginateURL("/paymentrequest", count, offset, params.values())
+	requests := &PaymentRequestList{}
+	err := s.client.call(ctx, "PaymentRequest.ListN", "GET", u, nil, requests)
+	return requests, err
+}
+
+// ListAll returns an iterator over all payment requests, fetching pages as needed
+func (s *PaymentRequestService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[PaymentRequest] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all payment requests matching params
+func (s *PaymentRequestService) ListAllWithParams(ctx context.Context, params *PaymentRequestListParams, opts *ListOptions) *Iterator[PaymentRequest] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]PaymentRequest, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
+
+// Notify sends the customer an email reminder of a payment request
+// For more details see https://developers.paystack.co/v1.0/reference#send-notification
+func (s *PaymentRequestService) Notify(code string) (Response, error) {
+	return s.NotifyWithContext(context.Background(), code)
+}
+
+// NotifyWithContext is like Notify but carries ctx through to the request
+func (s *PaymentRequestService) NotifyWithContext(ctx context.Context, code string) (Response, error) {
+	u := fmt.Sprintf("/paymentrequest/notify/%s", code)
+	resp := Response{}
+	err := s.client.call(ctx, "PaymentRequest.Notify", "POST", u, nil, &resp)
+	return resp, err
+}
+
+// Totals returns the total amount of payment requests by status
+// For more details see https://developers.paystack.co/v1.0/reference#payment-request-total
+func (s *PaymentRequestService) Totals() (*PaymentRequestTotals, error) {
+	return s.TotalsWithContext(context.Background())
+}
+
+// TotalsWithContext is like Totals but carries ctx through to the request
+func (s *PaymentRequestService) TotalsWithContext(ctx context.Context) (*PaymentRequestTotals, error) {
+	totals := &PaymentRequestTotals{}
+	var err error
+	totals.Raw, err = s.client.callRaw(ctx, "PaymentRequest.Totals", "GET", "/paymentrequest/totals", nil, totals)
+	return totals, err
+}
+
+// Finalize sends a draft payment request to the customer, by email unless
+// sendNotification is false
+// For more details see https://developers.paystack.co/v1.0/reference#finalize-payment-request
+func (s *PaymentRequestService) Finalize(code string, sendNotification bool) (*PaymentRequest, error) {
+	return s.FinalizeWithContext(context.Background(), code, sendNotification)
+}
+
+// FinalizeWithContext is like Finalize but carries ctx through to the request
+func (s *PaymentRequestService) FinalizeWithContext(ctx context.Context, code string, sendNotification bool) (*PaymentRequest, error) {
+	u := fmt.Sprintf("/paymentrequest/finalize/%s", code)
+	body := map[string]bool{"send_notification": sendNotification}
+	return s.request(ctx, "PaymentRequest.Finalize", "POST", u, body)
+}
+
+// Archive archives a payment request, so it is no longer listed and cannot be paid
+// For more details see https://developers.paystack.co/v1.0/reference#archive-payment-request
+func (s *PaymentRequestService) Archive(code string) (Response, error) {
+	return s.ArchiveWithContext(context.Background(), code)
+}
+
+// ArchiveWithContext is like Archive but carries ctx through to the request
+func (s *PaymentRequestService) ArchiveWithContext(ctx context.Context, code string) (Response, error) {
+	u := fmt.Sprintf("/paymentrequest/archive/%s", code)
+	resp := Response{}
+	err := s.client.call(ctx, "PaymentRequest.Archive", "POST", u, nil, &resp)
+	return resp, err
+}
@@ -0,0 +1,159 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rpip/paystack-go/paystacktest"
+)
+
+func TestPaymentRequestTotal(t *testing.T) {
+	req := &PaymentRequestRequest{
+		LineItems: []LineItem{{Name: "Tripod", Amount: 25000, Quantity: 2}, {Name: "Lens cap", Amount: 1500}},
+		Tax:       []Tax{{Name: "VAT", Amount: 3862}},
+	}
+	if got := req.Total(); got != 55362 {
+		t.Errorf("Expected line items plus tax, got %d", got)
+	}
+	req.Amount = 60000
+	if got := req.Total(); got != 60000 {
+		t.Errorf("Expected the explicit amount, got %d", got)
+	}
+}
+
+func TestPaymentRequestPartialUpdate(t *testing.T) {
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = nil
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{"status":true,"message":"Payment request updated","data":{"request_code":"PRQ_1"}}`))
+	}))
+	defer ts.Close()
+	client, err := NewClientWithOptions("sk_test_key", WithBaseURL(ts.URL), WithDefaultCurrency(GHS))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.PaymentRequest.Update("PRQ_1", &PaymentRequestRequest{Description: "Deposit"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent) != 1 || sent["description"] != "Deposit" {
+		t.Errorf("Expected only the description to be sent, got %v", sent)
+	}
+	if _, err := client.PaymentRequest.Update("PRQ_1", &PaymentRequestRequest{Amount: 75000}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sent["currency"]; ok || sent["amount"] != float64(75000) {
+		t.Errorf("Expected the amount without a currency, got %v", sent)
+	}
+
+	sent = nil
+	if _, err := client.PaymentRequest.Update("PRQ_1", &PaymentRequestRequest{Amount: 100, Currency: NGN}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected an amount below the minimum to fail validation, got %v", err)
+	}
+	if sent != nil {
+		t.Errorf("Expected nothing to be sent, got %v", sent)
+	}
+	if _, err := client.PaymentRequest.Update("PRQ_1", nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil request to fail validation, got %v", err)
+	}
+}
+
+func TestPaymentRequests(t *testing.T) {
+	srv := paystacktest.NewServer()
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	cust, err := client.Customer.Create(&Customer{Email: "accounts@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.PaymentRequest.Create(&PaymentRequestRequest{Customer: cust.CustomerCode}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected missing amount to fail validation, got %v", err)
+	}
+
+	pr, err := client.PaymentRequest.Create(&PaymentRequestRequest{
+		Customer:    cust.CustomerCode,
+		Description: "March retainer",
+		DueDate:     "2030-03-31",
+		LineItems:   []LineItem{{Name: "Design", Amount: 150000, Quantity: 2}},
+		Tax:         []Tax{{Name: "VAT", Amount: 22500}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.RequestCode == "" || pr.Amount != 322500 || pr.Status != PaymentRequestPending || pr.Currency != NGN {
+		t.Errorf("Unexpected payment request %+v", pr)
+	}
+	if pr.Customer.ID != cust.ID || len(pr.LineItems) != 1 || pr.LineItems[0].Quantity != 2 || len(pr.Tax) != 1 {
+		t.Errorf("Expected customer, line items and tax to be decoded, got %+v", pr)
+	}
+	if len(pr.Notifications) != 1 {
+		t.Errorf("Expected the customer to be notified, got %+v", pr.Notifications)
+	}
+
+	draft, err := client.PaymentRequest.Create(&PaymentRequestRequest{Customer: cust.CustomerCode, Amount: 50000, Draft: true})
+	if err != nil || draft.Status != PaymentRequestDraft || len(draft.Notifications) != 0 {
+		t.Fatalf("Expected an unsent draft, got %+v, %v", draft, err)
+	}
+	if _, err := client.PaymentRequest.Notify(draft.RequestCode); err == nil {
+		t.Error("Expected a draft not to be sent")
+	}
+	if draft, err = client.PaymentRequest.Update(draft.RequestCode, &PaymentRequestRequest{Amount: 75000, Description: "Deposit"}); err != nil || draft.Amount != 75000 {
+		t.Errorf("Expected updated draft, got %+v, %v", draft, err)
+	}
+	if draft, err = client.PaymentRequest.Finalize(draft.RequestCode, false); err != nil || draft.Status != PaymentRequestPending || len(draft.Notifications) != 0 {
+		t.Errorf("Expected finalized draft without notification, got %+v, %v", draft, err)
+	}
+	if _, err := client.PaymentRequest.Finalize(draft.RequestCode, true); err == nil {
+		t.Error("Expected a finalized payment request to be rejected")
+	}
+	if _, err := client.PaymentRequest.Notify(draft.RequestCode); err != nil {
+		t.Error(err)
+	}
+
+	if err := srv.PayPaymentRequest(pr.RequestCode); err != nil {
+		t.Fatal(err)
+	}
+	verified, err := client.PaymentRequest.Verify(pr.RequestCode)
+	if err != nil || !verified.Paid || verified.Status != PaymentRequestSuccess || verified.PendingAmount != 0 {
+		t.Errorf("Expected paid payment request, got %+v, %v", verified, err)
+	}
+	got, err := client.PaymentRequest.Get(draft.RequestCode)
+	if err != nil || got.Customer.Email != "accounts@example.com" || got.Description != "Deposit" {
+		t.Errorf("Expected to fetch the payment request, got %+v, %v", got, err)
+	}
+	if _, err := client.PaymentRequest.Get("PRQ_missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+
+	totals, err := client.PaymentRequest.Totals()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(totals.Successful) != 1 || totals.Successful[0].Amount != 322500 || len(totals.Pending) != 1 || totals.Pending[0].Amount != 75000 {
+		t.Errorf("Unexpected totals %+v", totals)
+	}
+
+	if _, err := client.PaymentRequest.Archive(draft.RequestCode); err != nil {
+		t.Fatal(err)
+	}
+	list, err := client.PaymentRequest.ListNWithParams(10, 1, &PaymentRequestListParams{Customer: cust.ID})
+	if err != nil || len(list.Values) != 1 || list.Values[0].RequestCode != pr.RequestCode {
+		t.Errorf("Expected archived payment requests to be left out, got %+v, %v", list, err)
+	}
+	var n int
+	for _, err := range client.PaymentRequest.ListAllWithParams(context.Background(), &PaymentRequestListParams{IncludeArchive: true}, nil).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 2 {
+		t.Errorf("Expected to iterate over 2 payment requests, got %d", n)
+	}
+}
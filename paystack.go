@@ -2,6 +2,7 @@ package paystack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -27,8 +29,10 @@ const (
 	baseURL = "https://api.paystack.co"
 
 	// User agent used when communicating with the Paystack API.
-	// userAgent = "paystack-go/" + version
-	userAgent = "Mozilla/5.0 (Unknown; Linux) AppleWebKit/538.1 (KHTML, like Gecko) Chrome/v1.0.0 Safari/538.1"
+	userAgent = "paystack-go/" + version
+
+	// legacyUserAgent is the browser-like user agent sent by clients created with NewClient
+	legacyUserAgent = "Mozilla/5.0 (Unknown; Linux) AppleWebKit/538.1 (KHTML, like Gecko) Chrome/v1.0.0 Safari/538.1"
 )
 
 type service struct {
@@ -45,20 +49,34 @@ type Client struct {
 
 	baseURL *url.URL
 
+	userAgent       string
+	defaultCurrency Currency
+
 	logger Logger
 	// Services supported by the Paystack API.
 	// Miscellaneous actions are directly implemented on the Client object
-	Customer     *CustomerService
-	Transaction  *TransactionService
-	SubAccount   *SubAccountService
-	Plan         *PlanService
-	Subscription *SubscriptionService
-	Page         *PageService
-	Settlement   *SettlementService
-	Transfer     *TransferService
-	Charge       *ChargeService
-	Bank         *BankService
-	BulkCharge   *BulkChargeService
+	Customer         *CustomerService
+	Transaction      *TransactionService
+	SubAccount       *SubAccountService
+	Plan             *PlanService
+	Subscription     *SubscriptionService
+	Page             *PageService
+	Settlement       *SettlementService
+	Transfer         *TransferService
+	Charge           *ChargeService
+	Bank             *BankService
+	BulkCharge       *BulkChargeService
+	Refund           *RefundService
+	Dispute          *DisputeService
+	DedicatedAccount *DedicatedAccountService
+	PaymentRequest   *PaymentRequestService
+	Product          *ProductService
+
+	// RetryPolicy controls how failed requests are retried.
+	// A nil policy disables retries.
+	RetryPolicy *RetryPolicy
+
+	middleware []Middleware
 
 	LoggingEnabled bool
 	Log            Logger
@@ -100,18 +118,33 @@ type ListMeta struct {
 // and HTTP client, allowing overriding of the HTTP client to use.
 // This is useful if you're running in a Google AppEngine environment
 // where the http.DefaultClient is not available.
+// NewClient logs to stderr; use NewClientWithOptions for finer control.
 func NewClient(key string, httpClient *http.Client) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{Timeout: defaultHTTPTimeout}
-	}
+	c, _ := NewClientWithOptions(key,
+		WithHTTPClient(httpClient),
+		WithUserAgent(legacyUserAgent),
+		WithLogger(log.New(os.Stderr, "", log.LstdFlags)),
+	)
+	return c
+}
 
+// NewClientWithOptions creates a new Paystack API client with the given API key,
+// configured by opts. Without options the client talks to the live API with a
+// 60 second timeout, the default retry policy and logging disabled.
+func NewClientWithOptions(key string, opts ...Option) (*Client, error) {
 	u, _ := url.Parse(baseURL)
 	c := &Client{
-		client:         httpClient,
-		key:            key,
-		baseURL:        u,
-		LoggingEnabled: true,
-		Log:            log.New(os.Stderr, "", log.LstdFlags),
+		client:      &http.Client{Timeout: defaultHTTPTimeout},
+		key:         key,
+		baseURL:     u,
+		userAgent:   userAgent,
+		RetryPolicy: DefaultRetryPolicy(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
 	}
 
 	c.common.client = c
@@ -126,94 +159,251 @@ func NewClient(key string, httpClient *http.Client) *Client {
 	c.Charge = (*ChargeService)(&c.common)
 	c.Bank = (*BankService)(&c.common)
 	c.BulkCharge = (*BulkChargeService)(&c.common)
+	c.Refund = (*RefundService)(&c.common)
+	c.Dispute = (*DisputeService)(&c.common)
+	c.DedicatedAccount = (*DedicatedAccountService)(&c.common)
+	c.PaymentRequest = (*PaymentRequestService)(&c.common)
+	c.Product = (*ProductService)(&c.common)
 
-	return c
+	return c, nil
 }
 
 // Call actually does the HTTP request to Paystack API
 func (c *Client) Call(method, path string, body, v interface{}) error {
-	var buf io.ReadWriter
-	if body != nil {
-		buf = new(bytes.Buffer)
-		err := json.NewEncoder(buf).Encode(body)
+	return c.CallContext(context.Background(), method, path, body, v)
+}
+
+// CallContext is like Call but binds the HTTP request to ctx, so that
+// cancellation, deadlines and request-scoped values reach the transport
+func (c *Client) CallContext(ctx context.Context, method, path string, body, v interface{}) error {
+	return c.call(ctx, method+" "+path, method, path, body, v)
+}
+
+// call runs the named API operation through the middleware chain
+func (c *Client) call(ctx context.Context, name, method, path string, body, v interface{}) error {
+	return c.chain()(ctx, newOperation(name, method, path, body, v))
+}
+
+// callRaw is like call but also returns the payload untyped, for results
+// that keep the raw payload. Middleware sees v as the result, as with call,
+// and the raw payload is read from the response body afterwards.
+func (c *Client) callRaw(ctx context.Context, name, method, path string, body, v interface{}) (Response, error) {
+	op := newOperation(name, method, path, body, v)
+	if err := c.chain()(ctx, op); err != nil || op.Response == nil {
+		return Response{}, err
+	}
+	var resp Response
+	if err := json.Unmarshal(op.Response.Body, &resp); err != nil {
+		return Response{}, err
+	}
+	return payload(resp), nil
+}
+
+func newOperation(name, method, path string, body, v interface{}) *Operation {
+	return &Operation{
+		Name:   name,
+		Method: method,
+		Path:   path,
+		Header: make(http.Header),
+		Body:   body,
+		Result: v,
+	}
+}
+
+// send performs the operation over HTTP. It is the innermost RoundTripFunc.
+func (c *Client) send(ctx context.Context, op *Operation) error {
+	var payload []byte
+	if op.Body != nil {
+		buf := new(bytes.Buffer)
+		err := json.NewEncoder(buf).Encode(op.Body)
 		if err != nil {
 			return err
 		}
+		payload = buf.Bytes()
 	}
-	u, _ := c.baseURL.Parse(path)
-	req, err := http.NewRequest(method, u.String(), buf)
-
+	u, err := c.baseURL.Parse(strings.TrimPrefix(op.Path, "/"))
 	if err != nil {
-		if c.LoggingEnabled {
-			c.Log.Printf("Cannot create Paystack request: %v\n", err)
-		}
 		return err
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
-	req.Header.Set("Authorization", "Bearer "+c.key)
-	req.Header.Set("User-Agent", userAgent)
-
 	if c.LoggingEnabled {
-		c.Log.Printf("Requesting %v %v%v\n", req.Method, req.URL.Host, req.URL.Path)
-		c.Log.Printf("POST request data %v\n", buf)
+		c.Log.Printf("Requesting %v %v%v\n", op.Method, u.Host, u.Path)
+		c.Log.Printf("POST request data %s\n", payload)
 	}
 
 	start := time.Now()
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(ctx, op.Method, u.String(), payload, op.Header)
 	if err != nil {
 		return err
 	}
 
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return &NetworkError{Err: err}
+	}
+	latency := time.Since(start)
+
 	if c.LoggingEnabled {
-		c.Log.Printf("Completed in %v\n", time.Since(start))
+		c.Log.Printf("Completed in %v\n", latency)
 	}
 
-	defer resp.Body.Close()
-	return c.decodeResponse(resp, v)
+	op.StatusCode = resp.StatusCode
+	op.Response = newResponseInfo(resp, respBody, latency)
+	if info := responseInfo(ctx); info != nil {
+		*info = *op.Response
+	}
+	return c.decodeResponse(resp, respBody, op.Result)
+}
+
+// newRequest builds an authenticated request for a single attempt.
+// The payload is wrapped afresh each time so it can be resent on retry.
+func (c *Client) newRequest(ctx context.Context, method, url string, payload []byte, header http.Header) (*http.Request, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+
+	if err != nil {
+		if c.LoggingEnabled {
+			c.Log.Printf("Cannot create Paystack request: %v\n", err)
+		}
+		return nil, err
+	}
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+c.key)
+	req.Header.Set("User-Agent", c.userAgent)
+	if key := idempotencyKey(ctx); key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	return req, nil
 }
 
 // ResolveCardBIN docs https://developers.paystack.co/v1.0/reference#resolve-card-bin
 func (c *Client) ResolveCardBIN(bin int) (Response, error) {
+	return c.ResolveCardBINWithContext(context.Background(), bin)
+}
+
+// ResolveCardBINWithContext is like ResolveCardBIN but carries ctx through to the request
+func (c *Client) ResolveCardBINWithContext(ctx context.Context, bin int) (Response, error) {
 	u := fmt.Sprintf("/decision/bin/%d", bin)
 	resp := Response{}
-	err := c.Call("GET", u, nil, &resp)
+	err := c.call(ctx, "ResolveCardBIN", "GET", u, nil, &resp)
 
 	return resp, err
 }
 
-// CheckBalance docs https://developers.paystack.co/v1.0/reference#resolve-card-bin
-func (c *Client) CheckBalance() (Response, error) {
+// Balance is the balance of the integration in one currency
+type Balance struct {
+	Currency Currency `json:"currency,omitempty"`
+	Amount   Amount   `json:"balance,omitempty"`
+	// Raw is the balance as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// SessionTimeout is the time allowed to complete a payment session
+type SessionTimeout struct {
+	// Timeout is in seconds, 0 means sessions do not time out
+	Timeout int `json:"payment_session_timeout"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// CheckBalance returns the balance of the integration in each of its currencies
+// For more details see https://developers.paystack.co/v1.0/reference#check-balance
+func (c *Client) CheckBalance() ([]Balance, error) {
+	return c.CheckBalanceWithContext(context.Background())
+}
+
+// CheckBalanceWithContext is like CheckBalance but carries ctx through to the request
+func (c *Client) CheckBalanceWithContext(ctx context.Context) ([]Balance, error) {
 	resp := Response{}
-	err := c.Call("GET", "balance", nil, &resp)
+	err := c.call(ctx, "CheckBalance", "GET", "balance", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
 	// check balance 'data' node is an array
-	resp2 := resp["data"].([]interface{})[0].(map[string]interface{})
-	return resp2, err
+	items, _ := resp["data"].([]interface{})
+	balances := make([]Balance, 0, len(items))
+	for _, item := range items {
+		raw, _ := item.(map[string]interface{})
+		b := Balance{Raw: raw}
+		if err := mapstruct(raw, &b); err != nil {
+			return nil, err
+		}
+		balances = append(balances, b)
+	}
+	return balances, nil
 }
 
 // GetSessionTimeout fetches payment session timeout
-func (c *Client) GetSessionTimeout() (Response, error) {
-	resp := Response{}
-	err := c.Call("GET", "/integration/payment_session_timeout", nil, &resp)
-	return resp, err
+func (c *Client) GetSessionTimeout() (*SessionTimeout, error) {
+	return c.GetSessionTimeoutWithContext(context.Background())
+}
+
+// GetSessionTimeoutWithContext is like GetSessionTimeout but carries ctx through to the request
+func (c *Client) GetSessionTimeoutWithContext(ctx context.Context) (*SessionTimeout, error) {
+	timeout := &SessionTimeout{}
+	var err error
+	timeout.Raw, err = c.callRaw(ctx, "GetSessionTimeout", "GET", "/integration/payment_session_timeout", nil, timeout)
+	return timeout, err
 }
 
 // UpdateSessionTimeout updates payment session timeout
-func (c *Client) UpdateSessionTimeout(timeout int) (Response, error) {
+func (c *Client) UpdateSessionTimeout(timeout int) (*SessionTimeout, error) {
+	return c.UpdateSessionTimeoutWithContext(context.Background(), timeout)
+}
+
+// UpdateSessionTimeoutWithContext is like UpdateSessionTimeout but carries ctx through to the request
+func (c *Client) UpdateSessionTimeoutWithContext(ctx context.Context, timeout int) (*SessionTimeout, error) {
 	data := url.Values{}
 	data.Add("timeout", strconv.Itoa(timeout))
-	resp := Response{}
+	result := &SessionTimeout{}
 	u := "/integration/payment_session_timeout"
-	err := c.Call("PUT", u, data, &resp)
-	return resp, err
+	var err error
+	result.Raw, err = c.callRaw(ctx, "UpdateSessionTimeout", "PUT", u, data, result)
+	return result, err
 }
 
 // INTERNALS
-func paginateURL(path string, count, offset int) string {
-	return fmt.Sprintf("%s?perPage=%d&page=%d", path, count, offset)
+// currency returns cur, or the client's default currency if cur is empty
+func (c *Client) currency(cur Currency) Currency {
+	if cur == "" {
+		return c.defaultCurrency
+	}
+	return cur
+}
+
+func paginateURL(path string, count, offset int, params url.Values) string {
+	q := url.Values{}
+	for k, v := range params {
+		q[k] = v
+	}
+	q.Set("perPage", strconv.Itoa(count))
+	q.Set("page", strconv.Itoa(offset))
+	return path + "?" + q.Encode()
+}
+
+// setTime adds t to q as an ISO 8601 timestamp unless it is zero
+func setTime(q url.Values, key string, t time.Time) {
+	if !t.IsZero() {
+		q.Set(key, t.UTC().Format(time.RFC3339))
+	}
+}
+
+// setInt adds n to q unless it is zero
+func setInt(q url.Values, key string, n int64) {
+	if n != 0 {
+		q.Set(key, strconv.FormatInt(n, 10))
+	}
 }
 
 func mapstruct(data interface{}, v interface{}) error {
@@ -230,44 +420,34 @@ func mapstruct(data interface{}, v interface{}) error {
 	return err
 }
 
-func mustGetTestKey() string {
-	key := os.Getenv("PAYSTACK_KEY")
-
-	if len(key) == 0 {
-		panic("PAYSTACK_KEY environment variable is not set\n")
-	}
-
-	return key
-}
-
 // decodeResponse decodes the JSON response from the Twitter API.
 // The actual response will be written to the `v` parameter
-func (c *Client) decodeResponse(httpResp *http.Response, v interface{}) error {
+func (c *Client) decodeResponse(httpResp *http.Response, respBody []byte, v interface{}) error {
 	var resp Response
-	respBody, err := ioutil.ReadAll(httpResp.Body)
 	json.Unmarshal(respBody, &resp)
 
 	if status, _ := resp["status"].(bool); !status || httpResp.StatusCode >= 400 {
+		var details ErrorResponse
+		json.Unmarshal(respBody, &details)
 		if c.LoggingEnabled {
-			c.Log.Printf("Paystack error: %+v", err)
+			c.Log.Printf("Paystack error: %s", details.Message)
 			c.Log.Printf("HTTP Response: %+v", resp)
 		}
-		return newAPIError(httpResp)
+		return newAPIError(httpResp, respBody, details)
 	}
 
 	if c.LoggingEnabled {
 		c.Log.Printf("Paystack response: %v\n", resp)
 	}
 
-	if data, ok := resp["data"]; ok {
-		switch t := resp["data"].(type) {
-		case map[string]interface{}:
-			return mapstruct(data, v)
-		default:
-			_ = t
-			return mapstruct(resp, v)
-		}
+	return mapstruct(payload(resp), v)
+}
+
+// payload returns the part of the envelope that is decoded into results:
+// the data object, or the entire response if data is missing or not an object
+func payload(resp Response) Response {
+	if data, ok := resp["data"].(map[string]interface{}); ok {
+		return data
 	}
-	// if response data does not contain data key, map entire response to v
-	return mapstruct(resp, v)
+	return resp
 }
@@ -1,12 +1,41 @@
 package paystack
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rpip/paystack-go/paystacktest"
+)
 
 var c *Client
 
-func init() {
-	apiKey := mustGetTestKey()
-	c = NewClient(apiKey, nil)
+// TestMain runs the suite against the live API when PAYSTACK_KEY is set,
+// and against the in-process fake otherwise
+func TestMain(m *testing.M) {
+	if key := os.Getenv("PAYSTACK_KEY"); key != "" {
+		c = NewClient(key, nil)
+		os.Exit(m.Run())
+	}
+
+	srv := paystacktest.NewServer()
+	c = newTestClient(srv.URL)
+	code := m.Run()
+	srv.Close()
+	os.Exit(code)
+}
+
+// newTestClient returns a quiet client pointed at a local test server
+func newTestClient(serverURL string) *Client {
+	client, err := NewClientWithOptions("sk_test_key", WithBaseURL(serverURL))
+	if err != nil {
+		panic(err)
+	}
+	return client
 }
 
 func TestResolveCardBIN(t *testing.T) {
@@ -24,11 +53,14 @@ func TestCheckBalance(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	if _, ok := resp["currency"]; !ok {
+	if len(resp) == 0 {
+		t.Fatal("Expected at least one balance")
+	}
+	if resp[0].Currency == "" {
 		t.Errorf("Expected response to contain currency")
 	}
 
-	if _, ok := resp["balance"]; !ok {
+	if _, ok := resp[0].Raw["balance"]; !ok {
 		t.Errorf("Expected response to contain balance")
 	}
 }
@@ -38,7 +70,7 @@ func TestSessionTimeout(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	if _, ok := resp["payment_session_timeout"]; !ok {
+	if _, ok := resp.Raw["payment_session_timeout"]; !ok {
 		t.Errorf("Expected response to contain payment_session_timeout")
 	}
 
@@ -49,8 +81,25 @@ func TestSessionTimeout(t *testing.T) {
 				t.Error(err)
 			}
 
-			if _, ok := resp["payment_session_timeout"]; !ok {
+			if resp.Timeout != 30 {
 				t.Errorf("Expected response to contain payment_session_timeout")
 			}
 	*/
 }
+
+func TestCallContextCancel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Transaction.VerifyWithContext(ctx, "ref-123")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context deadline exceeded, got %v", err)
+	}
+}
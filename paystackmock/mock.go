@@ -0,0 +1,80 @@
+// Package paystackmock provides mock implementations of the paystack service
+// interfaces for testing code that uses the client.
+//
+// Each mock has a Func field per method. A call runs the field if it is set
+// and is recorded either way, so tests can stub only what they use and then
+// check what was called:
+//
+//	client := paystackmock.NewClient()
+//	client.Transaction.VerifyFunc = func(reference string) (*paystack.Transaction, error) {
+//		return &paystack.Transaction{Reference: reference, Status: "success"}, nil
+//	}
+//	checkout := NewCheckout(client) // takes a paystack.API
+//	...
+//	if calls := client.Transaction.CallsTo("Verify"); len(calls) != 1 {
+//		t.Errorf("Expected one verification, got %d", len(calls))
+//	}
+//
+// A plain method with no stub falls back to the stub of its WithContext
+// variant. Methods with neither return zero values and an error wrapping
+// ErrNotStubbed; list iterators yield nothing and report that error from Err.
+package paystackmock
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotStubbed is returned by mock methods whose Func field is not set
+var ErrNotStubbed = errors.New("paystackmock: method not stubbed")
+
+func notStubbed(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, method)
+}
+
+// Call is a recorded method call
+type Call struct {
+	Method string
+	// Args are the arguments of the call, without any context.Context
+	Args []interface{}
+}
+
+// Recorder records the calls made to a mock. It is embedded in every mock.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made so far, in order
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made so far to method
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Reset forgets the recorded calls
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
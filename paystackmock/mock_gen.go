@@ -0,0 +1,3762 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package paystackmock
+
+import (
+	"context"
+	"time"
+
+	paystack "github.com/rpip/paystack-go"
+)
+
+// CustomerAPI is a mock paystack.CustomerAPI
+type CustomerAPI struct {
+	Recorder
+
+	CreateFunc                             func(customer *paystack.Customer) (*paystack.Customer, error)
+	CreateWithContextFunc                  func(ctx context.Context, customer *paystack.Customer) (*paystack.Customer, error)
+	UpdateFunc                             func(customer *paystack.Customer) (*paystack.Customer, error)
+	UpdateWithContextFunc                  func(ctx context.Context, customer *paystack.Customer) (*paystack.Customer, error)
+	GetFunc                                func(customerCode string) (*paystack.Customer, error)
+	GetWithContextFunc                     func(ctx context.Context, customerCode string) (*paystack.Customer, error)
+	ListFunc                               func() (*paystack.CustomerList, error)
+	ListWithContextFunc                    func(ctx context.Context) (*paystack.CustomerList, error)
+	ListNFunc                              func(count int, offset int) (*paystack.CustomerList, error)
+	ListNWithContextFunc                   func(ctx context.Context, count int, offset int) (*paystack.CustomerList, error)
+	ListNWithParamsFunc                    func(count int, offset int, params *paystack.CustomerListParams) (*paystack.CustomerList, error)
+	ListNWithParamsWithContextFunc         func(ctx context.Context, count int, offset int, params *paystack.CustomerListParams) (*paystack.CustomerList, error)
+	ListAllFunc                            func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Customer]
+	ListAllWithParamsFunc                  func(ctx context.Context, params *paystack.CustomerListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Customer]
+	SetRiskActionFunc                      func(customerCode string, riskAction string) (*paystack.Customer, error)
+	SetRiskActionWithContextFunc           func(ctx context.Context, customerCode string, riskAction string) (*paystack.Customer, error)
+	DeactivateAuthorizationFunc            func(authorizationCode string) (*paystack.Response, error)
+	DeactivateAuthorizationWithContextFunc func(ctx context.Context, authorizationCode string) (*paystack.Response, error)
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *CustomerAPI) Create(customer *paystack.Customer) (*paystack.Customer, error) {
+	m.record("Create", customer)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(customer)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), customer)
+	}
+	var r0 *paystack.Customer
+	return r0, notStubbed("CustomerAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *CustomerAPI) CreateWithContext(ctx context.Context, customer *paystack.Customer) (*paystack.Customer, error) {
+	m.record("CreateWithContext", customer)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, customer)
+	}
+	var r0 *paystack.Customer
+	return r0, notStubbed("CustomerAPI.CreateWithContext")
+}
+
+// Update records the call and runs UpdateFunc,
+// or UpdateWithContextFunc with a background context
+func (m *CustomerAPI) Update(customer *paystack.Customer) (*paystack.Customer, error) {
+	m.record("Update", customer)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(customer)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), customer)
+	}
+	var r0 *paystack.Customer
+	return r0, notStubbed("CustomerAPI.Update")
+}
+
+// UpdateWithContext records the call and runs UpdateWithContextFunc
+func (m *CustomerAPI) UpdateWithContext(ctx context.Context, customer *paystack.Customer) (*paystack.Customer, error) {
+	m.record("UpdateWithContext", customer)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, customer)
+	}
+	var r0 *paystack.Customer
+	return r0, notStubbed("CustomerAPI.UpdateWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *CustomerAPI) Get(customerCode string) (*paystack.Customer, error) {
+	m.record("Get", customerCode)
+	if m.GetFunc != nil {
+		return m.GetFunc(customerCode)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), customerCode)
+	}
+	var r0 *paystack.Customer
+	return r0, notStubbed("CustomerAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *CustomerAPI) GetWithContext(ctx context.Context, customerCode string) (*paystack.Customer, error) {
+	m.record("GetWithContext", customerCode)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, customerCode)
+	}
+	var r0 *paystack.Customer
+	return r0, notStubbed("CustomerAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *CustomerAPI) List() (*paystack.CustomerList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.CustomerList
+	return r0, notStubbed("CustomerAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *CustomerAPI) ListWithContext(ctx context.Context) (*paystack.CustomerList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.CustomerList
+	return r0, notStubbed("CustomerAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *CustomerAPI) ListN(count int, offset int) (*paystack.CustomerList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.CustomerList
+	return r0, notStubbed("CustomerAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *CustomerAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.CustomerList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.CustomerList
+	return r0, notStubbed("CustomerAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *CustomerAPI) ListNWithParams(count int, offset int, params *paystack.CustomerListParams) (*paystack.CustomerList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.CustomerList
+	return r0, notStubbed("CustomerAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *CustomerAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.CustomerListParams) (*paystack.CustomerList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.CustomerList
+	return r0, notStubbed("CustomerAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *CustomerAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Customer] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Customer](notStubbed("CustomerAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *CustomerAPI) ListAllWithParams(ctx context.Context, params *paystack.CustomerListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Customer] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.Customer](notStubbed("CustomerAPI.ListAllWithParams"))
+}
+
+// SetRiskAction records the call and runs SetRiskActionFunc,
+// or SetRiskActionWithContextFunc with a background context
+func (m *CustomerAPI) SetRiskAction(customerCode string, riskAction string) (*paystack.Customer, error) {
+	m.record("SetRiskAction", customerCode, riskAction)
+	if m.SetRiskActionFunc != nil {
+		return m.SetRiskActionFunc(customerCode, riskAction)
+	}
+	if m.SetRiskActionWithContextFunc != nil {
+		return m.SetRiskActionWithContextFunc(context.Background(), customerCode, riskAction)
+	}
+	var r0 *paystack.Customer
+	return r0, notStubbed("CustomerAPI.SetRiskAction")
+}
+
+// SetRiskActionWithContext records the call and runs SetRiskActionWithContextFunc
+func (m *CustomerAPI) SetRiskActionWithContext(ctx context.Context, customerCode string, riskAction string) (*paystack.Customer, error) {
+	m.record("SetRiskActionWithContext", customerCode, riskAction)
+	if m.SetRiskActionWithContextFunc != nil {
+		return m.SetRiskActionWithContextFunc(ctx, customerCode, riskAction)
+	}
+	var r0 *paystack.Customer
+	return r0, notStubbed("CustomerAPI.SetRiskActionWithContext")
+}
+
+// DeactivateAuthorization records the call and runs DeactivateAuthorizationFunc,
+// or DeactivateAuthorizationWithContextFunc with a background context
+func (m *CustomerAPI) DeactivateAuthorization(authorizationCode string) (*paystack.Response, error) {
+	m.record("DeactivateAuthorization", authorizationCode)
+	if m.DeactivateAuthorizationFunc != nil {
+		return m.DeactivateAuthorizationFunc(authorizationCode)
+	}
+	if m.DeactivateAuthorizationWithContextFunc != nil {
+		return m.DeactivateAuthorizationWithContextFunc(context.Background(), authorizationCode)
+	}
+	var r0 *paystack.Response
+	return r0, notStubbed("CustomerAPI.DeactivateAuthorization")
+}
+
+// DeactivateAuthorizationWithContext records the call and runs DeactivateAuthorizationWithContextFunc
+func (m *CustomerAPI) DeactivateAuthorizationWithContext(ctx context.Context, authorizationCode string) (*paystack.Response, error) {
+	m.record("DeactivateAuthorizationWithContext", authorizationCode)
+	if m.DeactivateAuthorizationWithContextFunc != nil {
+		return m.DeactivateAuthorizationWithContextFunc(ctx, authorizationCode)
+	}
+	var r0 *paystack.Response
+	return r0, notStubbed("CustomerAPI.DeactivateAuthorizationWithContext")
+}
+
+// TransactionAPI is a mock paystack.TransactionAPI
+type TransactionAPI struct {
+	Recorder
+
+	InitializeFunc                     func(txn *paystack.TransactionRequest) (*paystack.InitializeResult, error)
+	InitializeWithContextFunc          func(ctx context.Context, txn *paystack.TransactionRequest) (*paystack.InitializeResult, error)
+	VerifyFunc                         func(reference string) (*paystack.Transaction, error)
+	VerifyWithContextFunc              func(ctx context.Context, reference string) (*paystack.Transaction, error)
+	ListFunc                           func() (*paystack.TransactionList, error)
+	ListWithContextFunc                func(ctx context.Context) (*paystack.TransactionList, error)
+	ListNFunc                          func(count int, offset int) (*paystack.TransactionList, error)
+	ListNWithContextFunc               func(ctx context.Context, count int, offset int) (*paystack.TransactionList, error)
+	ListNWithParamsFunc                func(count int, offset int, params *paystack.TransactionListParams) (*paystack.TransactionList, error)
+	ListNWithParamsWithContextFunc     func(ctx context.Context, count int, offset int, params *paystack.TransactionListParams) (*paystack.TransactionList, error)
+	ListAllFunc                        func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Transaction]
+	ListAllWithParamsFunc              func(ctx context.Context, params *paystack.TransactionListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Transaction]
+	GetFunc                            func(id int) (*paystack.Transaction, error)
+	GetWithContextFunc                 func(ctx context.Context, id int) (*paystack.Transaction, error)
+	ChargeAuthorizationFunc            func(req *paystack.TransactionRequest) (*paystack.Transaction, error)
+	ChargeAuthorizationWithContextFunc func(ctx context.Context, req *paystack.TransactionRequest) (*paystack.Transaction, error)
+	TimelineFunc                       func(reference string) (*paystack.TransactionTimeline, error)
+	TimelineWithContextFunc            func(ctx context.Context, reference string) (*paystack.TransactionTimeline, error)
+	TotalsFunc                         func() (*paystack.TransactionTotals, error)
+	TotalsWithContextFunc              func(ctx context.Context) (*paystack.TransactionTotals, error)
+	ExportFunc                         func(params *paystack.ExportParams) (*paystack.ExportResult, error)
+	ExportWithContextFunc              func(ctx context.Context, params *paystack.ExportParams) (*paystack.ExportResult, error)
+	ReAuthorizeFunc                    func(req paystack.AuthorizationRequest) (*paystack.ReAuthorizeResult, error)
+	ReAuthorizeWithContextFunc         func(ctx context.Context, req paystack.AuthorizationRequest) (*paystack.ReAuthorizeResult, error)
+	CheckAuthorizationFunc             func(req paystack.AuthorizationRequest) (*paystack.CheckAuthorizationResult, error)
+	CheckAuthorizationWithContextFunc  func(ctx context.Context, req paystack.AuthorizationRequest) (*paystack.CheckAuthorizationResult, error)
+	DownloadExportFunc                 func(path string) ([]paystack.Transaction, error)
+	DownloadExportWithContextFunc      func(ctx context.Context, path string) ([]paystack.Transaction, error)
+}
+
+// Initialize records the call and runs InitializeFunc,
+// or InitializeWithContextFunc with a background context
+func (m *TransactionAPI) Initialize(txn *paystack.TransactionRequest) (*paystack.InitializeResult, error) {
+	m.record("Initialize", txn)
+	if m.InitializeFunc != nil {
+		return m.InitializeFunc(txn)
+	}
+	if m.InitializeWithContextFunc != nil {
+		return m.InitializeWithContextFunc(context.Background(), txn)
+	}
+	var r0 *paystack.InitializeResult
+	return r0, notStubbed("TransactionAPI.Initialize")
+}
+
+// InitializeWithContext records the call and runs InitializeWithContextFunc
+func (m *TransactionAPI) InitializeWithContext(ctx context.Context, txn *paystack.TransactionRequest) (*paystack.InitializeResult, error) {
+	m.record("InitializeWithContext", txn)
+	if m.InitializeWithContextFunc != nil {
+		return m.InitializeWithContextFunc(ctx, txn)
+	}
+	var r0 *paystack.InitializeResult
+	return r0, notStubbed("TransactionAPI.InitializeWithContext")
+}
+
+// Verify records the call and runs VerifyFunc,
+// or VerifyWithContextFunc with a background context
+func (m *TransactionAPI) Verify(reference string) (*paystack.Transaction, error) {
+	m.record("Verify", reference)
+	if m.VerifyFunc != nil {
+		return m.VerifyFunc(reference)
+	}
+	if m.VerifyWithContextFunc != nil {
+		return m.VerifyWithContextFunc(context.Background(), reference)
+	}
+	var r0 *paystack.Transaction
+	return r0, notStubbed("TransactionAPI.Verify")
+}
+
+// VerifyWithContext records the call and runs VerifyWithContextFunc
+func (m *TransactionAPI) VerifyWithContext(ctx context.Context, reference string) (*paystack.Transaction, error) {
+	m.record("VerifyWithContext", reference)
+	if m.VerifyWithContextFunc != nil {
+		return m.VerifyWithContextFunc(ctx, reference)
+	}
+	var r0 *paystack.Transaction
+	return r0, notStubbed("TransactionAPI.VerifyWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *TransactionAPI) List() (*paystack.TransactionList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.TransactionList
+	return r0, notStubbed("TransactionAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *TransactionAPI) ListWithContext(ctx context.Context) (*paystack.TransactionList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.TransactionList
+	return r0, notStubbed("TransactionAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *TransactionAPI) ListN(count int, offset int) (*paystack.TransactionList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.TransactionList
+	return r0, notStubbed("TransactionAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *TransactionAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.TransactionList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.TransactionList
+	return r0, notStubbed("TransactionAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *TransactionAPI) ListNWithParams(count int, offset int, params *paystack.TransactionListParams) (*paystack.TransactionList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.TransactionList
+	return r0, notStubbed("TransactionAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *TransactionAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.TransactionListParams) (*paystack.TransactionList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.TransactionList
+	return r0, notStubbed("TransactionAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *TransactionAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Transaction] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Transaction](notStubbed("TransactionAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *TransactionAPI) ListAllWithParams(ctx context.Context, params *paystack.TransactionListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Transaction] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.Transaction](notStubbed("TransactionAPI.ListAllWithParams"))
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *TransactionAPI) Get(id int) (*paystack.Transaction, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.Transaction
+	return r0, notStubbed("TransactionAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *TransactionAPI) GetWithContext(ctx context.Context, id int) (*paystack.Transaction, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.Transaction
+	return r0, notStubbed("TransactionAPI.GetWithContext")
+}
+
+// ChargeAuthorization records the call and runs ChargeAuthorizationFunc,
+// or ChargeAuthorizationWithContextFunc with a background context
+func (m *TransactionAPI) ChargeAuthorization(req *paystack.TransactionRequest) (*paystack.Transaction, error) {
+	m.record("ChargeAuthorization", req)
+	if m.ChargeAuthorizationFunc != nil {
+		return m.ChargeAuthorizationFunc(req)
+	}
+	if m.ChargeAuthorizationWithContextFunc != nil {
+		return m.ChargeAuthorizationWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.Transaction
+	return r0, notStubbed("TransactionAPI.ChargeAuthorization")
+}
+
+// ChargeAuthorizationWithContext records the call and runs ChargeAuthorizationWithContextFunc
+func (m *TransactionAPI) ChargeAuthorizationWithContext(ctx context.Context, req *paystack.TransactionRequest) (*paystack.Transaction, error) {
+	m.record("ChargeAuthorizationWithContext", req)
+	if m.ChargeAuthorizationWithContextFunc != nil {
+		return m.ChargeAuthorizationWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.Transaction
+	return r0, notStubbed("TransactionAPI.ChargeAuthorizationWithContext")
+}
+
+// Timeline records the call and runs TimelineFunc,
+// or TimelineWithContextFunc with a background context
+func (m *TransactionAPI) Timeline(reference string) (*paystack.TransactionTimeline, error) {
+	m.record("Timeline", reference)
+	if m.TimelineFunc != nil {
+		return m.TimelineFunc(reference)
+	}
+	if m.TimelineWithContextFunc != nil {
+		return m.TimelineWithContextFunc(context.Background(), reference)
+	}
+	var r0 *paystack.TransactionTimeline
+	return r0, notStubbed("TransactionAPI.Timeline")
+}
+
+// TimelineWithContext records the call and runs TimelineWithContextFunc
+func (m *TransactionAPI) TimelineWithContext(ctx context.Context, reference string) (*paystack.TransactionTimeline, error) {
+	m.record("TimelineWithContext", reference)
+	if m.TimelineWithContextFunc != nil {
+		return m.TimelineWithContextFunc(ctx, reference)
+	}
+	var r0 *paystack.TransactionTimeline
+	return r0, notStubbed("TransactionAPI.TimelineWithContext")
+}
+
+// Totals records the call and runs TotalsFunc,
+// or TotalsWithContextFunc with a background context
+func (m *TransactionAPI) Totals() (*paystack.TransactionTotals, error) {
+	m.record("Totals")
+	if m.TotalsFunc != nil {
+		return m.TotalsFunc()
+	}
+	if m.TotalsWithContextFunc != nil {
+		return m.TotalsWithContextFunc(context.Background())
+	}
+	var r0 *paystack.TransactionTotals
+	return r0, notStubbed("TransactionAPI.Totals")
+}
+
+// TotalsWithContext records the call and runs TotalsWithContextFunc
+func (m *TransactionAPI) TotalsWithContext(ctx context.Context) (*paystack.TransactionTotals, error) {
+	m.record("TotalsWithContext")
+	if m.TotalsWithContextFunc != nil {
+		return m.TotalsWithContextFunc(ctx)
+	}
+	var r0 *paystack.TransactionTotals
+	return r0, notStubbed("TransactionAPI.TotalsWithContext")
+}
+
+// Export records the call and runs ExportFunc,
+// or ExportWithContextFunc with a background context
+func (m *TransactionAPI) Export(params *paystack.ExportParams) (*paystack.ExportResult, error) {
+	m.record("Export", params)
+	if m.ExportFunc != nil {
+		return m.ExportFunc(params)
+	}
+	if m.ExportWithContextFunc != nil {
+		return m.ExportWithContextFunc(context.Background(), params)
+	}
+	var r0 *paystack.ExportResult
+	return r0, notStubbed("TransactionAPI.Export")
+}
+
+// ExportWithContext records the call and runs ExportWithContextFunc
+func (m *TransactionAPI) ExportWithContext(ctx context.Context, params *paystack.ExportParams) (*paystack.ExportResult, error) {
+	m.record("ExportWithContext", params)
+	if m.ExportWithContextFunc != nil {
+		return m.ExportWithContextFunc(ctx, params)
+	}
+	var r0 *paystack.ExportResult
+	return r0, notStubbed("TransactionAPI.ExportWithContext")
+}
+
+// ReAuthorize records the call and runs ReAuthorizeFunc,
+// or ReAuthorizeWithContextFunc with a background context
+func (m *TransactionAPI) ReAuthorize(req paystack.AuthorizationRequest) (*paystack.ReAuthorizeResult, error) {
+	m.record("ReAuthorize", req)
+	if m.ReAuthorizeFunc != nil {
+		return m.ReAuthorizeFunc(req)
+	}
+	if m.ReAuthorizeWithContextFunc != nil {
+		return m.ReAuthorizeWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.ReAuthorizeResult
+	return r0, notStubbed("TransactionAPI.ReAuthorize")
+}
+
+// ReAuthorizeWithContext records the call and runs ReAuthorizeWithContextFunc
+func (m *TransactionAPI) ReAuthorizeWithContext(ctx context.Context, req paystack.AuthorizationRequest) (*paystack.ReAuthorizeResult, error) {
+	m.record("ReAuthorizeWithContext", req)
+	if m.ReAuthorizeWithContextFunc != nil {
+		return m.ReAuthorizeWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.ReAuthorizeResult
+	return r0, notStubbed("TransactionAPI.ReAuthorizeWithContext")
+}
+
+// CheckAuthorization records the call and runs CheckAuthorizationFunc,
+// or CheckAuthorizationWithContextFunc with a background context
+func (m *TransactionAPI) CheckAuthorization(req paystack.AuthorizationRequest) (*paystack.CheckAuthorizationResult, error) {
+	m.record("CheckAuthorization", req)
+	if m.CheckAuthorizationFunc != nil {
+		return m.CheckAuthorizationFunc(req)
+	}
+	if m.CheckAuthorizationWithContextFunc != nil {
+		return m.CheckAuthorizationWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.CheckAuthorizationResult
+	return r0, notStubbed("TransactionAPI.CheckAuthorization")
+}
+
+// CheckAuthorizationWithContext records the call and runs CheckAuthorizationWithContextFunc
+func (m *TransactionAPI) CheckAuthorizationWithContext(ctx context.Context, req paystack.AuthorizationRequest) (*paystack.CheckAuthorizationResult, error) {
+	m.record("CheckAuthorizationWithContext", req)
+	if m.CheckAuthorizationWithContextFunc != nil {
+		return m.CheckAuthorizationWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.CheckAuthorizationResult
+	return r0, notStubbed("TransactionAPI.CheckAuthorizationWithContext")
+}
+
+// DownloadExport records the call and runs DownloadExportFunc,
+// or DownloadExportWithContextFunc with a background context
+func (m *TransactionAPI) DownloadExport(path string) ([]paystack.Transaction, error) {
+	m.record("DownloadExport", path)
+	if m.DownloadExportFunc != nil {
+		return m.DownloadExportFunc(path)
+	}
+	if m.DownloadExportWithContextFunc != nil {
+		return m.DownloadExportWithContextFunc(context.Background(), path)
+	}
+	var r0 []paystack.Transaction
+	return r0, notStubbed("TransactionAPI.DownloadExport")
+}
+
+// DownloadExportWithContext records the call and runs DownloadExportWithContextFunc
+func (m *TransactionAPI) DownloadExportWithContext(ctx context.Context, path string) ([]paystack.Transaction, error) {
+	m.record("DownloadExportWithContext", path)
+	if m.DownloadExportWithContextFunc != nil {
+		return m.DownloadExportWithContextFunc(ctx, path)
+	}
+	var r0 []paystack.Transaction
+	return r0, notStubbed("TransactionAPI.DownloadExportWithContext")
+}
+
+// SubAccountAPI is a mock paystack.SubAccountAPI
+type SubAccountAPI struct {
+	Recorder
+
+	CreateFunc            func(subaccount *paystack.SubAccount) (*paystack.SubAccount, error)
+	CreateWithContextFunc func(ctx context.Context, subaccount *paystack.SubAccount) (*paystack.SubAccount, error)
+	UpdateFunc            func(subaccount *paystack.SubAccount) (*paystack.SubAccount, error)
+	UpdateWithContextFunc func(ctx context.Context, subaccount *paystack.SubAccount) (*paystack.SubAccount, error)
+	GetFunc               func(id int) (*paystack.SubAccount, error)
+	GetWithContextFunc    func(ctx context.Context, id int) (*paystack.SubAccount, error)
+	ListFunc              func() (*paystack.SubAccountList, error)
+	ListWithContextFunc   func(ctx context.Context) (*paystack.SubAccountList, error)
+	ListNFunc             func(count int, offset int) (*paystack.SubAccountList, error)
+	ListNWithContextFunc  func(ctx context.Context, count int, offset int) (*paystack.SubAccountList, error)
+	ListAllFunc           func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.SubAccount]
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *SubAccountAPI) Create(subaccount *paystack.SubAccount) (*paystack.SubAccount, error) {
+	m.record("Create", subaccount)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(subaccount)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), subaccount)
+	}
+	var r0 *paystack.SubAccount
+	return r0, notStubbed("SubAccountAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *SubAccountAPI) CreateWithContext(ctx context.Context, subaccount *paystack.SubAccount) (*paystack.SubAccount, error) {
+	m.record("CreateWithContext", subaccount)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, subaccount)
+	}
+	var r0 *paystack.SubAccount
+	return r0, notStubbed("SubAccountAPI.CreateWithContext")
+}
+
+// Update records the call and runs UpdateFunc,
+// or UpdateWithContextFunc with a background context
+func (m *SubAccountAPI) Update(subaccount *paystack.SubAccount) (*paystack.SubAccount, error) {
+	m.record("Update", subaccount)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(subaccount)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), subaccount)
+	}
+	var r0 *paystack.SubAccount
+	return r0, notStubbed("SubAccountAPI.Update")
+}
+
+// UpdateWithContext records the call and runs UpdateWithContextFunc
+func (m *SubAccountAPI) UpdateWithContext(ctx context.Context, subaccount *paystack.SubAccount) (*paystack.SubAccount, error) {
+	m.record("UpdateWithContext", subaccount)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, subaccount)
+	}
+	var r0 *paystack.SubAccount
+	return r0, notStubbed("SubAccountAPI.UpdateWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *SubAccountAPI) Get(id int) (*paystack.SubAccount, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.SubAccount
+	return r0, notStubbed("SubAccountAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *SubAccountAPI) GetWithContext(ctx context.Context, id int) (*paystack.SubAccount, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.SubAccount
+	return r0, notStubbed("SubAccountAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *SubAccountAPI) List() (*paystack.SubAccountList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.SubAccountList
+	return r0, notStubbed("SubAccountAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *SubAccountAPI) ListWithContext(ctx context.Context) (*paystack.SubAccountList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.SubAccountList
+	return r0, notStubbed("SubAccountAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *SubAccountAPI) ListN(count int, offset int) (*paystack.SubAccountList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.SubAccountList
+	return r0, notStubbed("SubAccountAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *SubAccountAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.SubAccountList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.SubAccountList
+	return r0, notStubbed("SubAccountAPI.ListNWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *SubAccountAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.SubAccount] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.SubAccount](notStubbed("SubAccountAPI.ListAll"))
+}
+
+// PlanAPI is a mock paystack.PlanAPI
+type PlanAPI struct {
+	Recorder
+
+	CreateFunc            func(plan *paystack.Plan) (*paystack.Plan, error)
+	CreateWithContextFunc func(ctx context.Context, plan *paystack.Plan) (*paystack.Plan, error)
+	UpdateFunc            func(plan *paystack.Plan) (paystack.Response, error)
+	UpdateWithContextFunc func(ctx context.Context, plan *paystack.Plan) (paystack.Response, error)
+	GetFunc               func(id int) (*paystack.Plan, error)
+	GetWithContextFunc    func(ctx context.Context, id int) (*paystack.Plan, error)
+	ListFunc              func() (*paystack.PlanList, error)
+	ListWithContextFunc   func(ctx context.Context) (*paystack.PlanList, error)
+	ListNFunc             func(count int, offset int) (*paystack.PlanList, error)
+	ListNWithContextFunc  func(ctx context.Context, count int, offset int) (*paystack.PlanList, error)
+	ListAllFunc           func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Plan]
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *PlanAPI) Create(plan *paystack.Plan) (*paystack.Plan, error) {
+	m.record("Create", plan)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(plan)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), plan)
+	}
+	var r0 *paystack.Plan
+	return r0, notStubbed("PlanAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *PlanAPI) CreateWithContext(ctx context.Context, plan *paystack.Plan) (*paystack.Plan, error) {
+	m.record("CreateWithContext", plan)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, plan)
+	}
+	var r0 *paystack.Plan
+	return r0, notStubbed("PlanAPI.CreateWithContext")
+}
+
+// Update records the call and runs UpdateFunc,
+// or UpdateWithContextFunc with a background context
+func (m *PlanAPI) Update(plan *paystack.Plan) (paystack.Response, error) {
+	m.record("Update", plan)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(plan)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), plan)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("PlanAPI.Update")
+}
+
+// UpdateWithContext records the call and runs UpdateWithContextFunc
+func (m *PlanAPI) UpdateWithContext(ctx context.Context, plan *paystack.Plan) (paystack.Response, error) {
+	m.record("UpdateWithContext", plan)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, plan)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("PlanAPI.UpdateWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *PlanAPI) Get(id int) (*paystack.Plan, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.Plan
+	return r0, notStubbed("PlanAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *PlanAPI) GetWithContext(ctx context.Context, id int) (*paystack.Plan, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.Plan
+	return r0, notStubbed("PlanAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *PlanAPI) List() (*paystack.PlanList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.PlanList
+	return r0, notStubbed("PlanAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *PlanAPI) ListWithContext(ctx context.Context) (*paystack.PlanList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.PlanList
+	return r0, notStubbed("PlanAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *PlanAPI) ListN(count int, offset int) (*paystack.PlanList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.PlanList
+	return r0, notStubbed("PlanAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *PlanAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.PlanList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.PlanList
+	return r0, notStubbed("PlanAPI.ListNWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *PlanAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Plan] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Plan](notStubbed("PlanAPI.ListAll"))
+}
+
+// SubscriptionAPI is a mock paystack.SubscriptionAPI
+type SubscriptionAPI struct {
+	Recorder
+
+	CreateFunc                     func(subscription *paystack.SubscriptionRequest) (*paystack.Subscription, error)
+	CreateWithContextFunc          func(ctx context.Context, subscription *paystack.SubscriptionRequest) (*paystack.Subscription, error)
+	UpdateFunc                     func(subscription *paystack.Subscription) (*paystack.Subscription, error)
+	UpdateWithContextFunc          func(ctx context.Context, subscription *paystack.Subscription) (*paystack.Subscription, error)
+	GetFunc                        func(id int) (*paystack.Subscription, error)
+	GetWithContextFunc             func(ctx context.Context, id int) (*paystack.Subscription, error)
+	ListFunc                       func() (*paystack.SubscriptionList, error)
+	ListWithContextFunc            func(ctx context.Context) (*paystack.SubscriptionList, error)
+	ListNFunc                      func(count int, offset int) (*paystack.SubscriptionList, error)
+	ListNWithContextFunc           func(ctx context.Context, count int, offset int) (*paystack.SubscriptionList, error)
+	ListNWithParamsFunc            func(count int, offset int, params *paystack.SubscriptionListParams) (*paystack.SubscriptionList, error)
+	ListNWithParamsWithContextFunc func(ctx context.Context, count int, offset int, params *paystack.SubscriptionListParams) (*paystack.SubscriptionList, error)
+	ListAllFunc                    func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Subscription]
+	ListAllWithParamsFunc          func(ctx context.Context, params *paystack.SubscriptionListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Subscription]
+	EnableFunc                     func(subscriptionCode string, emailToken string) (paystack.Response, error)
+	EnableWithContextFunc          func(ctx context.Context, subscriptionCode string, emailToken string) (paystack.Response, error)
+	DisableFunc                    func(subscriptionCode string, emailToken string) (paystack.Response, error)
+	DisableWithContextFunc         func(ctx context.Context, subscriptionCode string, emailToken string) (paystack.Response, error)
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *SubscriptionAPI) Create(subscription *paystack.SubscriptionRequest) (*paystack.Subscription, error) {
+	m.record("Create", subscription)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(subscription)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), subscription)
+	}
+	var r0 *paystack.Subscription
+	return r0, notStubbed("SubscriptionAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *SubscriptionAPI) CreateWithContext(ctx context.Context, subscription *paystack.SubscriptionRequest) (*paystack.Subscription, error) {
+	m.record("CreateWithContext", subscription)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, subscription)
+	}
+	var r0 *paystack.Subscription
+	return r0, notStubbed("SubscriptionAPI.CreateWithContext")
+}
+
+// Update records the call and runs UpdateFunc,
+// or UpdateWithContextFunc with a background context
+func (m *SubscriptionAPI) Update(subscription *paystack.Subscription) (*paystack.Subscription, error) {
+	m.record("Update", subscription)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(subscription)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), subscription)
+	}
+	var r0 *paystack.Subscription
+	return r0, notStubbed("SubscriptionAPI.Update")
+}
+
+// UpdateWithContext records the call and runs UpdateWithContextFunc
+func (m *SubscriptionAPI) UpdateWithContext(ctx context.Context, subscription *paystack.Subscription) (*paystack.Subscription, error) {
+	m.record("UpdateWithContext", subscription)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, subscription)
+	}
+	var r0 *paystack.Subscription
+	return r0, notStubbed("SubscriptionAPI.UpdateWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *SubscriptionAPI) Get(id int) (*paystack.Subscription, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.Subscription
+	return r0, notStubbed("SubscriptionAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *SubscriptionAPI) GetWithContext(ctx context.Context, id int) (*paystack.Subscription, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.Subscription
+	return r0, notStubbed("SubscriptionAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *SubscriptionAPI) List() (*paystack.SubscriptionList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.SubscriptionList
+	return r0, notStubbed("SubscriptionAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *SubscriptionAPI) ListWithContext(ctx context.Context) (*paystack.SubscriptionList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.SubscriptionList
+	return r0, notStubbed("SubscriptionAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *SubscriptionAPI) ListN(count int, offset int) (*paystack.SubscriptionList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.SubscriptionList
+	return r0, notStubbed("SubscriptionAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *SubscriptionAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.SubscriptionList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.SubscriptionList
+	return r0, notStubbed("SubscriptionAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *SubscriptionAPI) ListNWithParams(count int, offset int, params *paystack.SubscriptionListParams) (*paystack.SubscriptionList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.SubscriptionList
+	return r0, notStubbed("SubscriptionAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *SubscriptionAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.SubscriptionListParams) (*paystack.SubscriptionList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.SubscriptionList
+	return r0, notStubbed("SubscriptionAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *SubscriptionAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Subscription] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Subscription](notStubbed("SubscriptionAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *SubscriptionAPI) ListAllWithParams(ctx context.Context, params *paystack.SubscriptionListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Subscription] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.Subscription](notStubbed("SubscriptionAPI.ListAllWithParams"))
+}
+
+// Enable records the call and runs EnableFunc,
+// or EnableWithContextFunc with a background context
+func (m *SubscriptionAPI) Enable(subscriptionCode string, emailToken string) (paystack.Response, error) {
+	m.record("Enable", subscriptionCode, emailToken)
+	if m.EnableFunc != nil {
+		return m.EnableFunc(subscriptionCode, emailToken)
+	}
+	if m.EnableWithContextFunc != nil {
+		return m.EnableWithContextFunc(context.Background(), subscriptionCode, emailToken)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("SubscriptionAPI.Enable")
+}
+
+// EnableWithContext records the call and runs EnableWithContextFunc
+func (m *SubscriptionAPI) EnableWithContext(ctx context.Context, subscriptionCode string, emailToken string) (paystack.Response, error) {
+	m.record("EnableWithContext", subscriptionCode, emailToken)
+	if m.EnableWithContextFunc != nil {
+		return m.EnableWithContextFunc(ctx, subscriptionCode, emailToken)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("SubscriptionAPI.EnableWithContext")
+}
+
+// Disable records the call and runs DisableFunc,
+// or DisableWithContextFunc with a background context
+func (m *SubscriptionAPI) Disable(subscriptionCode string, emailToken string) (paystack.Response, error) {
+	m.record("Disable", subscriptionCode, emailToken)
+	if m.DisableFunc != nil {
+		return m.DisableFunc(subscriptionCode, emailToken)
+	}
+	if m.DisableWithContextFunc != nil {
+		return m.DisableWithContextFunc(context.Background(), subscriptionCode, emailToken)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("SubscriptionAPI.Disable")
+}
+
+// DisableWithContext records the call and runs DisableWithContextFunc
+func (m *SubscriptionAPI) DisableWithContext(ctx context.Context, subscriptionCode string, emailToken string) (paystack.Response, error) {
+	m.record("DisableWithContext", subscriptionCode, emailToken)
+	if m.DisableWithContextFunc != nil {
+		return m.DisableWithContextFunc(ctx, subscriptionCode, emailToken)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("SubscriptionAPI.DisableWithContext")
+}
+
+// PageAPI is a mock paystack.PageAPI
+type PageAPI struct {
+	Recorder
+
+	CreateFunc                 func(page *paystack.Page) (*paystack.Page, error)
+	CreateWithContextFunc      func(ctx context.Context, page *paystack.Page) (*paystack.Page, error)
+	UpdateFunc                 func(page *paystack.Page) (*paystack.Page, error)
+	UpdateWithContextFunc      func(ctx context.Context, page *paystack.Page) (*paystack.Page, error)
+	AddProductsFunc            func(pageID int, productIDs ...int) (*paystack.Page, error)
+	AddProductsWithContextFunc func(ctx context.Context, pageID int, productIDs ...int) (*paystack.Page, error)
+	GetFunc                    func(id int) (*paystack.Page, error)
+	GetWithContextFunc         func(ctx context.Context, id int) (*paystack.Page, error)
+	ListFunc                   func() (*paystack.PageList, error)
+	ListWithContextFunc        func(ctx context.Context) (*paystack.PageList, error)
+	ListNFunc                  func(count int, offset int) (*paystack.PageList, error)
+	ListNWithContextFunc       func(ctx context.Context, count int, offset int) (*paystack.PageList, error)
+	ListAllFunc                func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Page]
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *PageAPI) Create(page *paystack.Page) (*paystack.Page, error) {
+	m.record("Create", page)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(page)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), page)
+	}
+	var r0 *paystack.Page
+	return r0, notStubbed("PageAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *PageAPI) CreateWithContext(ctx context.Context, page *paystack.Page) (*paystack.Page, error) {
+	m.record("CreateWithContext", page)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, page)
+	}
+	var r0 *paystack.Page
+	return r0, notStubbed("PageAPI.CreateWithContext")
+}
+
+// Update records the call and runs UpdateFunc,
+// or UpdateWithContextFunc with a background context
+func (m *PageAPI) Update(page *paystack.Page) (*paystack.Page, error) {
+	m.record("Update", page)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(page)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), page)
+	}
+	var r0 *paystack.Page
+	return r0, notStubbed("PageAPI.Update")
+}
+
+// UpdateWithContext records the call and runs UpdateWithContextFunc
+func (m *PageAPI) UpdateWithContext(ctx context.Context, page *paystack.Page) (*paystack.Page, error) {
+	m.record("UpdateWithContext", page)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, page)
+	}
+	var r0 *paystack.Page
+	return r0, notStubbed("PageAPI.UpdateWithContext")
+}
+
+// AddProducts records the call and runs AddProductsFunc,
+// or AddProductsWithContextFunc with a background context
+func (m *PageAPI) AddProducts(pageID int, productIDs ...int) (*paystack.Page, error) {
+	m.record("AddProducts", pageID, productIDs)
+	if m.AddProductsFunc != nil {
+		return m.AddProductsFunc(pageID, productIDs...)
+	}
+	if m.AddProductsWithContextFunc != nil {
+		return m.AddProductsWithContextFunc(context.Background(), pageID, productIDs...)
+	}
+	var r0 *paystack.Page
+	return r0, notStubbed("PageAPI.AddProducts")
+}
+
+// AddProductsWithContext records the call and runs AddProductsWithContextFunc
+func (m *PageAPI) AddProductsWithContext(ctx context.Context, pageID int, productIDs ...int) (*paystack.Page, error) {
+	m.record("AddProductsWithContext", pageID, productIDs)
+	if m.AddProductsWithContextFunc != nil {
+		return m.AddProductsWithContextFunc(ctx, pageID, productIDs...)
+	}
+	var r0 *paystack.Page
+	return r0, notStubbed("PageAPI.AddProductsWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *PageAPI) Get(id int) (*paystack.Page, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.Page
+	return r0, notStubbed("PageAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *PageAPI) GetWithContext(ctx context.Context, id int) (*paystack.Page, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.Page
+	return r0, notStubbed("PageAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *PageAPI) List() (*paystack.PageList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.PageList
+	return r0, notStubbed("PageAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *PageAPI) ListWithContext(ctx context.Context) (*paystack.PageList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.PageList
+	return r0, notStubbed("PageAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *PageAPI) ListN(count int, offset int) (*paystack.PageList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.PageList
+	return r0, notStubbed("PageAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *PageAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.PageList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.PageList
+	return r0, notStubbed("PageAPI.ListNWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *PageAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Page] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Page](notStubbed("PageAPI.ListAll"))
+}
+
+// SettlementAPI is a mock paystack.SettlementAPI
+type SettlementAPI struct {
+	Recorder
+
+	ListFunc             func() (*paystack.SettlementList, error)
+	ListWithContextFunc  func(ctx context.Context) (*paystack.SettlementList, error)
+	ListNFunc            func(count int, offset int) (*paystack.SettlementList, error)
+	ListNWithContextFunc func(ctx context.Context, count int, offset int) (*paystack.SettlementList, error)
+	ListAllFunc          func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Response]
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *SettlementAPI) List() (*paystack.SettlementList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.SettlementList
+	return r0, notStubbed("SettlementAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *SettlementAPI) ListWithContext(ctx context.Context) (*paystack.SettlementList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.SettlementList
+	return r0, notStubbed("SettlementAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *SettlementAPI) ListN(count int, offset int) (*paystack.SettlementList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.SettlementList
+	return r0, notStubbed("SettlementAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *SettlementAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.SettlementList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.SettlementList
+	return r0, notStubbed("SettlementAPI.ListNWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *SettlementAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Response] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Response](notStubbed("SettlementAPI.ListAll"))
+}
+
+// TransferAPI is a mock paystack.TransferAPI
+type TransferAPI struct {
+	Recorder
+
+	InitiateFunc                      func(req *paystack.TransferRequest) (*paystack.Transfer, error)
+	InitiateWithContextFunc           func(ctx context.Context, req *paystack.TransferRequest) (*paystack.Transfer, error)
+	FinalizeFunc                      func(code string, otp string) (*paystack.TransferFinalizeResult, error)
+	FinalizeWithContextFunc           func(ctx context.Context, code string, otp string) (*paystack.TransferFinalizeResult, error)
+	MakeBulkTransferFunc              func(req *paystack.BulkTransfer) (paystack.Response, error)
+	MakeBulkTransferWithContextFunc   func(ctx context.Context, req *paystack.BulkTransfer) (paystack.Response, error)
+	GetFunc                           func(idCode string) (*paystack.Transfer, error)
+	GetWithContextFunc                func(ctx context.Context, idCode string) (*paystack.Transfer, error)
+	ListFunc                          func() (*paystack.TransferList, error)
+	ListWithContextFunc               func(ctx context.Context) (*paystack.TransferList, error)
+	ListNFunc                         func(count int, offset int) (*paystack.TransferList, error)
+	ListNWithContextFunc              func(ctx context.Context, count int, offset int) (*paystack.TransferList, error)
+	ListNWithParamsFunc               func(count int, offset int, params *paystack.TransferListParams) (*paystack.TransferList, error)
+	ListNWithParamsWithContextFunc    func(ctx context.Context, count int, offset int, params *paystack.TransferListParams) (*paystack.TransferList, error)
+	ListAllFunc                       func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Transfer]
+	ListAllWithParamsFunc             func(ctx context.Context, params *paystack.TransferListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Transfer]
+	ResendOTPFunc                     func(transferCode string, reason string) (paystack.Response, error)
+	ResendOTPWithContextFunc          func(ctx context.Context, transferCode string, reason string) (paystack.Response, error)
+	EnableOTPFunc                     func() (paystack.Response, error)
+	EnableOTPWithContextFunc          func(ctx context.Context) (paystack.Response, error)
+	DisableOTPFunc                    func() (paystack.Response, error)
+	DisableOTPWithContextFunc         func(ctx context.Context) (paystack.Response, error)
+	FinalizeOTPDisableFunc            func(otp string) (paystack.Response, error)
+	FinalizeOTPDisableWithContextFunc func(ctx context.Context, otp string) (paystack.Response, error)
+	CreateRecipientFunc               func(recipient *paystack.TransferRecipient) (*paystack.TransferRecipient, error)
+	CreateRecipientWithContextFunc    func(ctx context.Context, recipient *paystack.TransferRecipient) (*paystack.TransferRecipient, error)
+	ListRecipientsFunc                func() (*paystack.TransferRecipientList, error)
+	ListRecipientsWithContextFunc     func(ctx context.Context) (*paystack.TransferRecipientList, error)
+	ListRecipientsNFunc               func(count int, offset int) (*paystack.TransferRecipientList, error)
+	ListRecipientsNWithContextFunc    func(ctx context.Context, count int, offset int) (*paystack.TransferRecipientList, error)
+	ListRecipientsAllFunc             func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.TransferRecipient]
+}
+
+// Initiate records the call and runs InitiateFunc,
+// or InitiateWithContextFunc with a background context
+func (m *TransferAPI) Initiate(req *paystack.TransferRequest) (*paystack.Transfer, error) {
+	m.record("Initiate", req)
+	if m.InitiateFunc != nil {
+		return m.InitiateFunc(req)
+	}
+	if m.InitiateWithContextFunc != nil {
+		return m.InitiateWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.Transfer
+	return r0, notStubbed("TransferAPI.Initiate")
+}
+
+// InitiateWithContext records the call and runs InitiateWithContextFunc
+func (m *TransferAPI) InitiateWithContext(ctx context.Context, req *paystack.TransferRequest) (*paystack.Transfer, error) {
+	m.record("InitiateWithContext", req)
+	if m.InitiateWithContextFunc != nil {
+		return m.InitiateWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.Transfer
+	return r0, notStubbed("TransferAPI.InitiateWithContext")
+}
+
+// Finalize records the call and runs FinalizeFunc,
+// or FinalizeWithContextFunc with a background context
+func (m *TransferAPI) Finalize(code string, otp string) (*paystack.TransferFinalizeResult, error) {
+	m.record("Finalize", code, otp)
+	if m.FinalizeFunc != nil {
+		return m.FinalizeFunc(code, otp)
+	}
+	if m.FinalizeWithContextFunc != nil {
+		return m.FinalizeWithContextFunc(context.Background(), code, otp)
+	}
+	var r0 *paystack.TransferFinalizeResult
+	return r0, notStubbed("TransferAPI.Finalize")
+}
+
+// FinalizeWithContext records the call and runs FinalizeWithContextFunc
+func (m *TransferAPI) FinalizeWithContext(ctx context.Context, code string, otp string) (*paystack.TransferFinalizeResult, error) {
+	m.record("FinalizeWithContext", code, otp)
+	if m.FinalizeWithContextFunc != nil {
+		return m.FinalizeWithContextFunc(ctx, code, otp)
+	}
+	var r0 *paystack.TransferFinalizeResult
+	return r0, notStubbed("TransferAPI.FinalizeWithContext")
+}
+
+// MakeBulkTransfer records the call and runs MakeBulkTransferFunc,
+// or MakeBulkTransferWithContextFunc with a background context
+func (m *TransferAPI) MakeBulkTransfer(req *paystack.BulkTransfer) (paystack.Response, error) {
+	m.record("MakeBulkTransfer", req)
+	if m.MakeBulkTransferFunc != nil {
+		return m.MakeBulkTransferFunc(req)
+	}
+	if m.MakeBulkTransferWithContextFunc != nil {
+		return m.MakeBulkTransferWithContextFunc(context.Background(), req)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.MakeBulkTransfer")
+}
+
+// MakeBulkTransferWithContext records the call and runs MakeBulkTransferWithContextFunc
+func (m *TransferAPI) MakeBulkTransferWithContext(ctx context.Context, req *paystack.BulkTransfer) (paystack.Response, error) {
+	m.record("MakeBulkTransferWithContext", req)
+	if m.MakeBulkTransferWithContextFunc != nil {
+		return m.MakeBulkTransferWithContextFunc(ctx, req)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.MakeBulkTransferWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *TransferAPI) Get(idCode string) (*paystack.Transfer, error) {
+	m.record("Get", idCode)
+	if m.GetFunc != nil {
+		return m.GetFunc(idCode)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), idCode)
+	}
+	var r0 *paystack.Transfer
+	return r0, notStubbed("TransferAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *TransferAPI) GetWithContext(ctx context.Context, idCode string) (*paystack.Transfer, error) {
+	m.record("GetWithContext", idCode)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, idCode)
+	}
+	var r0 *paystack.Transfer
+	return r0, notStubbed("TransferAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *TransferAPI) List() (*paystack.TransferList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.TransferList
+	return r0, notStubbed("TransferAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *TransferAPI) ListWithContext(ctx context.Context) (*paystack.TransferList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.TransferList
+	return r0, notStubbed("TransferAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *TransferAPI) ListN(count int, offset int) (*paystack.TransferList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.TransferList
+	return r0, notStubbed("TransferAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *TransferAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.TransferList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.TransferList
+	return r0, notStubbed("TransferAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *TransferAPI) ListNWithParams(count int, offset int, params *paystack.TransferListParams) (*paystack.TransferList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.TransferList
+	return r0, notStubbed("TransferAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *TransferAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.TransferListParams) (*paystack.TransferList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.TransferList
+	return r0, notStubbed("TransferAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *TransferAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Transfer] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Transfer](notStubbed("TransferAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *TransferAPI) ListAllWithParams(ctx context.Context, params *paystack.TransferListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Transfer] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.Transfer](notStubbed("TransferAPI.ListAllWithParams"))
+}
+
+// ResendOTP records the call and runs ResendOTPFunc,
+// or ResendOTPWithContextFunc with a background context
+func (m *TransferAPI) ResendOTP(transferCode string, reason string) (paystack.Response, error) {
+	m.record("ResendOTP", transferCode, reason)
+	if m.ResendOTPFunc != nil {
+		return m.ResendOTPFunc(transferCode, reason)
+	}
+	if m.ResendOTPWithContextFunc != nil {
+		return m.ResendOTPWithContextFunc(context.Background(), transferCode, reason)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.ResendOTP")
+}
+
+// ResendOTPWithContext records the call and runs ResendOTPWithContextFunc
+func (m *TransferAPI) ResendOTPWithContext(ctx context.Context, transferCode string, reason string) (paystack.Response, error) {
+	m.record("ResendOTPWithContext", transferCode, reason)
+	if m.ResendOTPWithContextFunc != nil {
+		return m.ResendOTPWithContextFunc(ctx, transferCode, reason)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.ResendOTPWithContext")
+}
+
+// EnableOTP records the call and runs EnableOTPFunc,
+// or EnableOTPWithContextFunc with a background context
+func (m *TransferAPI) EnableOTP() (paystack.Response, error) {
+	m.record("EnableOTP")
+	if m.EnableOTPFunc != nil {
+		return m.EnableOTPFunc()
+	}
+	if m.EnableOTPWithContextFunc != nil {
+		return m.EnableOTPWithContextFunc(context.Background())
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.EnableOTP")
+}
+
+// EnableOTPWithContext records the call and runs EnableOTPWithContextFunc
+func (m *TransferAPI) EnableOTPWithContext(ctx context.Context) (paystack.Response, error) {
+	m.record("EnableOTPWithContext")
+	if m.EnableOTPWithContextFunc != nil {
+		return m.EnableOTPWithContextFunc(ctx)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.EnableOTPWithContext")
+}
+
+// DisableOTP records the call and runs DisableOTPFunc,
+// or DisableOTPWithContextFunc with a background context
+func (m *TransferAPI) DisableOTP() (paystack.Response, error) {
+	m.record("DisableOTP")
+	if m.DisableOTPFunc != nil {
+		return m.DisableOTPFunc()
+	}
+	if m.DisableOTPWithContextFunc != nil {
+		return m.DisableOTPWithContextFunc(context.Background())
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.DisableOTP")
+}
+
+// DisableOTPWithContext records the call and runs DisableOTPWithContextFunc
+func (m *TransferAPI) DisableOTPWithContext(ctx context.Context) (paystack.Response, error) {
+	m.record("DisableOTPWithContext")
+	if m.DisableOTPWithContextFunc != nil {
+		return m.DisableOTPWithContextFunc(ctx)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.DisableOTPWithContext")
+}
+
+// FinalizeOTPDisable records the call and runs FinalizeOTPDisableFunc,
+// or FinalizeOTPDisableWithContextFunc with a background context
+func (m *TransferAPI) FinalizeOTPDisable(otp string) (paystack.Response, error) {
+	m.record("FinalizeOTPDisable", otp)
+	if m.FinalizeOTPDisableFunc != nil {
+		return m.FinalizeOTPDisableFunc(otp)
+	}
+	if m.FinalizeOTPDisableWithContextFunc != nil {
+		return m.FinalizeOTPDisableWithContextFunc(context.Background(), otp)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.FinalizeOTPDisable")
+}
+
+// FinalizeOTPDisableWithContext records the call and runs FinalizeOTPDisableWithContextFunc
+func (m *TransferAPI) FinalizeOTPDisableWithContext(ctx context.Context, otp string) (paystack.Response, error) {
+	m.record("FinalizeOTPDisableWithContext", otp)
+	if m.FinalizeOTPDisableWithContextFunc != nil {
+		return m.FinalizeOTPDisableWithContextFunc(ctx, otp)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("TransferAPI.FinalizeOTPDisableWithContext")
+}
+
+// CreateRecipient records the call and runs CreateRecipientFunc,
+// or CreateRecipientWithContextFunc with a background context
+func (m *TransferAPI) CreateRecipient(recipient *paystack.TransferRecipient) (*paystack.TransferRecipient, error) {
+	m.record("CreateRecipient", recipient)
+	if m.CreateRecipientFunc != nil {
+		return m.CreateRecipientFunc(recipient)
+	}
+	if m.CreateRecipientWithContextFunc != nil {
+		return m.CreateRecipientWithContextFunc(context.Background(), recipient)
+	}
+	var r0 *paystack.TransferRecipient
+	return r0, notStubbed("TransferAPI.CreateRecipient")
+}
+
+// CreateRecipientWithContext records the call and runs CreateRecipientWithContextFunc
+func (m *TransferAPI) CreateRecipientWithContext(ctx context.Context, recipient *paystack.TransferRecipient) (*paystack.TransferRecipient, error) {
+	m.record("CreateRecipientWithContext", recipient)
+	if m.CreateRecipientWithContextFunc != nil {
+		return m.CreateRecipientWithContextFunc(ctx, recipient)
+	}
+	var r0 *paystack.TransferRecipient
+	return r0, notStubbed("TransferAPI.CreateRecipientWithContext")
+}
+
+// ListRecipients records the call and runs ListRecipientsFunc,
+// or ListRecipientsWithContextFunc with a background context
+func (m *TransferAPI) ListRecipients() (*paystack.TransferRecipientList, error) {
+	m.record("ListRecipients")
+	if m.ListRecipientsFunc != nil {
+		return m.ListRecipientsFunc()
+	}
+	if m.ListRecipientsWithContextFunc != nil {
+		return m.ListRecipientsWithContextFunc(context.Background())
+	}
+	var r0 *paystack.TransferRecipientList
+	return r0, notStubbed("TransferAPI.ListRecipients")
+}
+
+// ListRecipientsWithContext records the call and runs ListRecipientsWithContextFunc
+func (m *TransferAPI) ListRecipientsWithContext(ctx context.Context) (*paystack.TransferRecipientList, error) {
+	m.record("ListRecipientsWithContext")
+	if m.ListRecipientsWithContextFunc != nil {
+		return m.ListRecipientsWithContextFunc(ctx)
+	}
+	var r0 *paystack.TransferRecipientList
+	return r0, notStubbed("TransferAPI.ListRecipientsWithContext")
+}
+
+// ListRecipientsN records the call and runs ListRecipientsNFunc,
+// or ListRecipientsNWithContextFunc with a background context
+func (m *TransferAPI) ListRecipientsN(count int, offset int) (*paystack.TransferRecipientList, error) {
+	m.record("ListRecipientsN", count, offset)
+	if m.ListRecipientsNFunc != nil {
+		return m.ListRecipientsNFunc(count, offset)
+	}
+	if m.ListRecipientsNWithContextFunc != nil {
+		return m.ListRecipientsNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.TransferRecipientList
+	return r0, notStubbed("TransferAPI.ListRecipientsN")
+}
+
+// ListRecipientsNWithContext records the call and runs ListRecipientsNWithContextFunc
+func (m *TransferAPI) ListRecipientsNWithContext(ctx context.Context, count int, offset int) (*paystack.TransferRecipientList, error) {
+	m.record("ListRecipientsNWithContext", count, offset)
+	if m.ListRecipientsNWithContextFunc != nil {
+		return m.ListRecipientsNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.TransferRecipientList
+	return r0, notStubbed("TransferAPI.ListRecipientsNWithContext")
+}
+
+// ListRecipientsAll records the call and runs ListRecipientsAllFunc
+func (m *TransferAPI) ListRecipientsAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.TransferRecipient] {
+	m.record("ListRecipientsAll", opts)
+	if m.ListRecipientsAllFunc != nil {
+		return m.ListRecipientsAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.TransferRecipient](notStubbed("TransferAPI.ListRecipientsAll"))
+}
+
+// ChargeAPI is a mock paystack.ChargeAPI
+type ChargeAPI struct {
+	Recorder
+
+	CreateFunc                    func(req *paystack.ChargeRequest) (*paystack.ChargeResult, error)
+	CreateWithContextFunc         func(ctx context.Context, req *paystack.ChargeRequest) (*paystack.ChargeResult, error)
+	TokenizeFunc                  func(req *paystack.ChargeRequest) (*paystack.TokenizeResult, error)
+	TokenizeWithContextFunc       func(ctx context.Context, req *paystack.ChargeRequest) (*paystack.TokenizeResult, error)
+	SubmitPINFunc                 func(pin string, reference string) (*paystack.ChargeResult, error)
+	SubmitPINWithContextFunc      func(ctx context.Context, pin string, reference string) (*paystack.ChargeResult, error)
+	SubmitOTPFunc                 func(otp string, reference string) (*paystack.ChargeResult, error)
+	SubmitOTPWithContextFunc      func(ctx context.Context, otp string, reference string) (*paystack.ChargeResult, error)
+	SubmitPhoneFunc               func(phone string, reference string) (*paystack.ChargeResult, error)
+	SubmitPhoneWithContextFunc    func(ctx context.Context, phone string, reference string) (*paystack.ChargeResult, error)
+	SubmitBirthdayFunc            func(birthday string, reference string) (*paystack.ChargeResult, error)
+	SubmitBirthdayWithContextFunc func(ctx context.Context, birthday string, reference string) (*paystack.ChargeResult, error)
+	CheckPendingFunc              func(reference string) (*paystack.ChargeResult, error)
+	CheckPendingWithContextFunc   func(ctx context.Context, reference string) (*paystack.ChargeResult, error)
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *ChargeAPI) Create(req *paystack.ChargeRequest) (*paystack.ChargeResult, error) {
+	m.record("Create", req)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(req)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *ChargeAPI) CreateWithContext(ctx context.Context, req *paystack.ChargeRequest) (*paystack.ChargeResult, error) {
+	m.record("CreateWithContext", req)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.CreateWithContext")
+}
+
+// Tokenize records the call and runs TokenizeFunc,
+// or TokenizeWithContextFunc with a background context
+func (m *ChargeAPI) Tokenize(req *paystack.ChargeRequest) (*paystack.TokenizeResult, error) {
+	m.record("Tokenize", req)
+	if m.TokenizeFunc != nil {
+		return m.TokenizeFunc(req)
+	}
+	if m.TokenizeWithContextFunc != nil {
+		return m.TokenizeWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.TokenizeResult
+	return r0, notStubbed("ChargeAPI.Tokenize")
+}
+
+// TokenizeWithContext records the call and runs TokenizeWithContextFunc
+func (m *ChargeAPI) TokenizeWithContext(ctx context.Context, req *paystack.ChargeRequest) (*paystack.TokenizeResult, error) {
+	m.record("TokenizeWithContext", req)
+	if m.TokenizeWithContextFunc != nil {
+		return m.TokenizeWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.TokenizeResult
+	return r0, notStubbed("ChargeAPI.TokenizeWithContext")
+}
+
+// SubmitPIN records the call and runs SubmitPINFunc,
+// or SubmitPINWithContextFunc with a background context
+func (m *ChargeAPI) SubmitPIN(pin string, reference string) (*paystack.ChargeResult, error) {
+	m.record("SubmitPIN", pin, reference)
+	if m.SubmitPINFunc != nil {
+		return m.SubmitPINFunc(pin, reference)
+	}
+	if m.SubmitPINWithContextFunc != nil {
+		return m.SubmitPINWithContextFunc(context.Background(), pin, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.SubmitPIN")
+}
+
+// SubmitPINWithContext records the call and runs SubmitPINWithContextFunc
+func (m *ChargeAPI) SubmitPINWithContext(ctx context.Context, pin string, reference string) (*paystack.ChargeResult, error) {
+	m.record("SubmitPINWithContext", pin, reference)
+	if m.SubmitPINWithContextFunc != nil {
+		return m.SubmitPINWithContextFunc(ctx, pin, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.SubmitPINWithContext")
+}
+
+// SubmitOTP records the call and runs SubmitOTPFunc,
+// or SubmitOTPWithContextFunc with a background context
+func (m *ChargeAPI) SubmitOTP(otp string, reference string) (*paystack.ChargeResult, error) {
+	m.record("SubmitOTP", otp, reference)
+	if m.SubmitOTPFunc != nil {
+		return m.SubmitOTPFunc(otp, reference)
+	}
+	if m.SubmitOTPWithContextFunc != nil {
+		return m.SubmitOTPWithContextFunc(context.Background(), otp, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.SubmitOTP")
+}
+
+// SubmitOTPWithContext records the call and runs SubmitOTPWithContextFunc
+func (m *ChargeAPI) SubmitOTPWithContext(ctx context.Context, otp string, reference string) (*paystack.ChargeResult, error) {
+	m.record("SubmitOTPWithContext", otp, reference)
+	if m.SubmitOTPWithContextFunc != nil {
+		return m.SubmitOTPWithContextFunc(ctx, otp, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.SubmitOTPWithContext")
+}
+
+// SubmitPhone records the call and runs SubmitPhoneFunc,
+// or SubmitPhoneWithContextFunc with a background context
+func (m *ChargeAPI) SubmitPhone(phone string, reference string) (*paystack.ChargeResult, error) {
+	m.record("SubmitPhone", phone, reference)
+	if m.SubmitPhoneFunc != nil {
+		return m.SubmitPhoneFunc(phone, reference)
+	}
+	if m.SubmitPhoneWithContextFunc != nil {
+		return m.SubmitPhoneWithContextFunc(context.Background(), phone, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.SubmitPhone")
+}
+
+// SubmitPhoneWithContext records the call and runs SubmitPhoneWithContextFunc
+func (m *ChargeAPI) SubmitPhoneWithContext(ctx context.Context, phone string, reference string) (*paystack.ChargeResult, error) {
+	m.record("SubmitPhoneWithContext", phone, reference)
+	if m.SubmitPhoneWithContextFunc != nil {
+		return m.SubmitPhoneWithContextFunc(ctx, phone, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.SubmitPhoneWithContext")
+}
+
+// SubmitBirthday records the call and runs SubmitBirthdayFunc,
+// or SubmitBirthdayWithContextFunc with a background context
+func (m *ChargeAPI) SubmitBirthday(birthday string, reference string) (*paystack.ChargeResult, error) {
+	m.record("SubmitBirthday", birthday, reference)
+	if m.SubmitBirthdayFunc != nil {
+		return m.SubmitBirthdayFunc(birthday, reference)
+	}
+	if m.SubmitBirthdayWithContextFunc != nil {
+		return m.SubmitBirthdayWithContextFunc(context.Background(), birthday, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.SubmitBirthday")
+}
+
+// SubmitBirthdayWithContext records the call and runs SubmitBirthdayWithContextFunc
+func (m *ChargeAPI) SubmitBirthdayWithContext(ctx context.Context, birthday string, reference string) (*paystack.ChargeResult, error) {
+	m.record("SubmitBirthdayWithContext", birthday, reference)
+	if m.SubmitBirthdayWithContextFunc != nil {
+		return m.SubmitBirthdayWithContextFunc(ctx, birthday, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.SubmitBirthdayWithContext")
+}
+
+// CheckPending records the call and runs CheckPendingFunc,
+// or CheckPendingWithContextFunc with a background context
+func (m *ChargeAPI) CheckPending(reference string) (*paystack.ChargeResult, error) {
+	m.record("CheckPending", reference)
+	if m.CheckPendingFunc != nil {
+		return m.CheckPendingFunc(reference)
+	}
+	if m.CheckPendingWithContextFunc != nil {
+		return m.CheckPendingWithContextFunc(context.Background(), reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.CheckPending")
+}
+
+// CheckPendingWithContext records the call and runs CheckPendingWithContextFunc
+func (m *ChargeAPI) CheckPendingWithContext(ctx context.Context, reference string) (*paystack.ChargeResult, error) {
+	m.record("CheckPendingWithContext", reference)
+	if m.CheckPendingWithContextFunc != nil {
+		return m.CheckPendingWithContextFunc(ctx, reference)
+	}
+	var r0 *paystack.ChargeResult
+	return r0, notStubbed("ChargeAPI.CheckPendingWithContext")
+}
+
+// BankAPI is a mock paystack.BankAPI
+type BankAPI struct {
+	Recorder
+
+	ListFunc                            func() (*paystack.BankList, error)
+	ListWithContextFunc                 func(ctx context.Context) (*paystack.BankList, error)
+	ResolveBVNFunc                      func(bvn int) (*paystack.BVNResponse, error)
+	ResolveBVNWithContextFunc           func(ctx context.Context, bvn int) (*paystack.BVNResponse, error)
+	ResolveAccountNumberFunc            func(accountNumber string, bankCode string) (*paystack.ResolvedAccount, error)
+	ResolveAccountNumberWithContextFunc func(ctx context.Context, accountNumber string, bankCode string) (*paystack.ResolvedAccount, error)
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *BankAPI) List() (*paystack.BankList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.BankList
+	return r0, notStubbed("BankAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *BankAPI) ListWithContext(ctx context.Context) (*paystack.BankList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.BankList
+	return r0, notStubbed("BankAPI.ListWithContext")
+}
+
+// ResolveBVN records the call and runs ResolveBVNFunc,
+// or ResolveBVNWithContextFunc with a background context
+func (m *BankAPI) ResolveBVN(bvn int) (*paystack.BVNResponse, error) {
+	m.record("ResolveBVN", bvn)
+	if m.ResolveBVNFunc != nil {
+		return m.ResolveBVNFunc(bvn)
+	}
+	if m.ResolveBVNWithContextFunc != nil {
+		return m.ResolveBVNWithContextFunc(context.Background(), bvn)
+	}
+	var r0 *paystack.BVNResponse
+	return r0, notStubbed("BankAPI.ResolveBVN")
+}
+
+// ResolveBVNWithContext records the call and runs ResolveBVNWithContextFunc
+func (m *BankAPI) ResolveBVNWithContext(ctx context.Context, bvn int) (*paystack.BVNResponse, error) {
+	m.record("ResolveBVNWithContext", bvn)
+	if m.ResolveBVNWithContextFunc != nil {
+		return m.ResolveBVNWithContextFunc(ctx, bvn)
+	}
+	var r0 *paystack.BVNResponse
+	return r0, notStubbed("BankAPI.ResolveBVNWithContext")
+}
+
+// ResolveAccountNumber records the call and runs ResolveAccountNumberFunc,
+// or ResolveAccountNumberWithContextFunc with a background context
+func (m *BankAPI) ResolveAccountNumber(accountNumber string, bankCode string) (*paystack.ResolvedAccount, error) {
+	m.record("ResolveAccountNumber", accountNumber, bankCode)
+	if m.ResolveAccountNumberFunc != nil {
+		return m.ResolveAccountNumberFunc(accountNumber, bankCode)
+	}
+	if m.ResolveAccountNumberWithContextFunc != nil {
+		return m.ResolveAccountNumberWithContextFunc(context.Background(), accountNumber, bankCode)
+	}
+	var r0 *paystack.ResolvedAccount
+	return r0, notStubbed("BankAPI.ResolveAccountNumber")
+}
+
+// ResolveAccountNumberWithContext records the call and runs ResolveAccountNumberWithContextFunc
+func (m *BankAPI) ResolveAccountNumberWithContext(ctx context.Context, accountNumber string, bankCode string) (*paystack.ResolvedAccount, error) {
+	m.record("ResolveAccountNumberWithContext", accountNumber, bankCode)
+	if m.ResolveAccountNumberWithContextFunc != nil {
+		return m.ResolveAccountNumberWithContextFunc(ctx, accountNumber, bankCode)
+	}
+	var r0 *paystack.ResolvedAccount
+	return r0, notStubbed("BankAPI.ResolveAccountNumberWithContext")
+}
+
+// BulkChargeAPI is a mock paystack.BulkChargeAPI
+type BulkChargeAPI struct {
+	Recorder
+
+	InitiateFunc                    func(req *paystack.BulkChargeRequest) (*paystack.BulkChargeBatch, error)
+	InitiateWithContextFunc         func(ctx context.Context, req *paystack.BulkChargeRequest) (*paystack.BulkChargeBatch, error)
+	ListFunc                        func() (*paystack.BulkChargeBatchList, error)
+	ListWithContextFunc             func(ctx context.Context) (*paystack.BulkChargeBatchList, error)
+	ListNFunc                       func(count int, offset int) (*paystack.BulkChargeBatchList, error)
+	ListNWithContextFunc            func(ctx context.Context, count int, offset int) (*paystack.BulkChargeBatchList, error)
+	ListAllFunc                     func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.BulkChargeBatch]
+	GetFunc                         func(idCode string) (*paystack.BulkChargeBatch, error)
+	GetWithContextFunc              func(ctx context.Context, idCode string) (*paystack.BulkChargeBatch, error)
+	GetBatchChargesFunc             func(idCode string) (*paystack.BatchChargeList, error)
+	GetBatchChargesWithContextFunc  func(ctx context.Context, idCode string) (*paystack.BatchChargeList, error)
+	PauseBulkChargeFunc             func(batchCode string) (paystack.Response, error)
+	PauseBulkChargeWithContextFunc  func(ctx context.Context, batchCode string) (paystack.Response, error)
+	ResumeBulkChargeFunc            func(batchCode string) (paystack.Response, error)
+	ResumeBulkChargeWithContextFunc func(ctx context.Context, batchCode string) (paystack.Response, error)
+}
+
+// Initiate records the call and runs InitiateFunc,
+// or InitiateWithContextFunc with a background context
+func (m *BulkChargeAPI) Initiate(req *paystack.BulkChargeRequest) (*paystack.BulkChargeBatch, error) {
+	m.record("Initiate", req)
+	if m.InitiateFunc != nil {
+		return m.InitiateFunc(req)
+	}
+	if m.InitiateWithContextFunc != nil {
+		return m.InitiateWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.BulkChargeBatch
+	return r0, notStubbed("BulkChargeAPI.Initiate")
+}
+
+// InitiateWithContext records the call and runs InitiateWithContextFunc
+func (m *BulkChargeAPI) InitiateWithContext(ctx context.Context, req *paystack.BulkChargeRequest) (*paystack.BulkChargeBatch, error) {
+	m.record("InitiateWithContext", req)
+	if m.InitiateWithContextFunc != nil {
+		return m.InitiateWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.BulkChargeBatch
+	return r0, notStubbed("BulkChargeAPI.InitiateWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *BulkChargeAPI) List() (*paystack.BulkChargeBatchList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.BulkChargeBatchList
+	return r0, notStubbed("BulkChargeAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *BulkChargeAPI) ListWithContext(ctx context.Context) (*paystack.BulkChargeBatchList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.BulkChargeBatchList
+	return r0, notStubbed("BulkChargeAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *BulkChargeAPI) ListN(count int, offset int) (*paystack.BulkChargeBatchList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.BulkChargeBatchList
+	return r0, notStubbed("BulkChargeAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *BulkChargeAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.BulkChargeBatchList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.BulkChargeBatchList
+	return r0, notStubbed("BulkChargeAPI.ListNWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *BulkChargeAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.BulkChargeBatch] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.BulkChargeBatch](notStubbed("BulkChargeAPI.ListAll"))
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *BulkChargeAPI) Get(idCode string) (*paystack.BulkChargeBatch, error) {
+	m.record("Get", idCode)
+	if m.GetFunc != nil {
+		return m.GetFunc(idCode)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), idCode)
+	}
+	var r0 *paystack.BulkChargeBatch
+	return r0, notStubbed("BulkChargeAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *BulkChargeAPI) GetWithContext(ctx context.Context, idCode string) (*paystack.BulkChargeBatch, error) {
+	m.record("GetWithContext", idCode)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, idCode)
+	}
+	var r0 *paystack.BulkChargeBatch
+	return r0, notStubbed("BulkChargeAPI.GetWithContext")
+}
+
+// GetBatchCharges records the call and runs GetBatchChargesFunc,
+// or GetBatchChargesWithContextFunc with a background context
+func (m *BulkChargeAPI) GetBatchCharges(idCode string) (*paystack.BatchChargeList, error) {
+	m.record("GetBatchCharges", idCode)
+	if m.GetBatchChargesFunc != nil {
+		return m.GetBatchChargesFunc(idCode)
+	}
+	if m.GetBatchChargesWithContextFunc != nil {
+		return m.GetBatchChargesWithContextFunc(context.Background(), idCode)
+	}
+	var r0 *paystack.BatchChargeList
+	return r0, notStubbed("BulkChargeAPI.GetBatchCharges")
+}
+
+// GetBatchChargesWithContext records the call and runs GetBatchChargesWithContextFunc
+func (m *BulkChargeAPI) GetBatchChargesWithContext(ctx context.Context, idCode string) (*paystack.BatchChargeList, error) {
+	m.record("GetBatchChargesWithContext", idCode)
+	if m.GetBatchChargesWithContextFunc != nil {
+		return m.GetBatchChargesWithContextFunc(ctx, idCode)
+	}
+	var r0 *paystack.BatchChargeList
+	return r0, notStubbed("BulkChargeAPI.GetBatchChargesWithContext")
+}
+
+// PauseBulkCharge records the call and runs PauseBulkChargeFunc,
+// or PauseBulkChargeWithContextFunc with a background context
+func (m *BulkChargeAPI) PauseBulkCharge(batchCode string) (paystack.Response, error) {
+	m.record("PauseBulkCharge", batchCode)
+	if m.PauseBulkChargeFunc != nil {
+		return m.PauseBulkChargeFunc(batchCode)
+	}
+	if m.PauseBulkChargeWithContextFunc != nil {
+		return m.PauseBulkChargeWithContextFunc(context.Background(), batchCode)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("BulkChargeAPI.PauseBulkCharge")
+}
+
+// PauseBulkChargeWithContext records the call and runs PauseBulkChargeWithContextFunc
+func (m *BulkChargeAPI) PauseBulkChargeWithContext(ctx context.Context, batchCode string) (paystack.Response, error) {
+	m.record("PauseBulkChargeWithContext", batchCode)
+	if m.PauseBulkChargeWithContextFunc != nil {
+		return m.PauseBulkChargeWithContextFunc(ctx, batchCode)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("BulkChargeAPI.PauseBulkChargeWithContext")
+}
+
+// ResumeBulkCharge records the call and runs ResumeBulkChargeFunc,
+// or ResumeBulkChargeWithContextFunc with a background context
+func (m *BulkChargeAPI) ResumeBulkCharge(batchCode string) (paystack.Response, error) {
+	m.record("ResumeBulkCharge", batchCode)
+	if m.ResumeBulkChargeFunc != nil {
+		return m.ResumeBulkChargeFunc(batchCode)
+	}
+	if m.ResumeBulkChargeWithContextFunc != nil {
+		return m.ResumeBulkChargeWithContextFunc(context.Background(), batchCode)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("BulkChargeAPI.ResumeBulkCharge")
+}
+
+// ResumeBulkChargeWithContext records the call and runs ResumeBulkChargeWithContextFunc
+func (m *BulkChargeAPI) ResumeBulkChargeWithContext(ctx context.Context, batchCode string) (paystack.Response, error) {
+	m.record("ResumeBulkChargeWithContext", batchCode)
+	if m.ResumeBulkChargeWithContextFunc != nil {
+		return m.ResumeBulkChargeWithContextFunc(ctx, batchCode)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("BulkChargeAPI.ResumeBulkChargeWithContext")
+}
+
+// RefundAPI is a mock paystack.RefundAPI
+type RefundAPI struct {
+	Recorder
+
+	CreateFunc                     func(req *paystack.RefundRequest) (*paystack.Refund, error)
+	CreateWithContextFunc          func(ctx context.Context, req *paystack.RefundRequest) (*paystack.Refund, error)
+	GetFunc                        func(id int) (*paystack.Refund, error)
+	GetWithContextFunc             func(ctx context.Context, id int) (*paystack.Refund, error)
+	ListFunc                       func() (*paystack.RefundList, error)
+	ListWithContextFunc            func(ctx context.Context) (*paystack.RefundList, error)
+	ListNFunc                      func(count int, offset int) (*paystack.RefundList, error)
+	ListNWithContextFunc           func(ctx context.Context, count int, offset int) (*paystack.RefundList, error)
+	ListNWithParamsFunc            func(count int, offset int, params *paystack.RefundListParams) (*paystack.RefundList, error)
+	ListNWithParamsWithContextFunc func(ctx context.Context, count int, offset int, params *paystack.RefundListParams) (*paystack.RefundList, error)
+	ListAllFunc                    func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Refund]
+	ListAllWithParamsFunc          func(ctx context.Context, params *paystack.RefundListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Refund]
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *RefundAPI) Create(req *paystack.RefundRequest) (*paystack.Refund, error) {
+	m.record("Create", req)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(req)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.Refund
+	return r0, notStubbed("RefundAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *RefundAPI) CreateWithContext(ctx context.Context, req *paystack.RefundRequest) (*paystack.Refund, error) {
+	m.record("CreateWithContext", req)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.Refund
+	return r0, notStubbed("RefundAPI.CreateWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *RefundAPI) Get(id int) (*paystack.Refund, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.Refund
+	return r0, notStubbed("RefundAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *RefundAPI) GetWithContext(ctx context.Context, id int) (*paystack.Refund, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.Refund
+	return r0, notStubbed("RefundAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *RefundAPI) List() (*paystack.RefundList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.RefundList
+	return r0, notStubbed("RefundAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *RefundAPI) ListWithContext(ctx context.Context) (*paystack.RefundList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.RefundList
+	return r0, notStubbed("RefundAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *RefundAPI) ListN(count int, offset int) (*paystack.RefundList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.RefundList
+	return r0, notStubbed("RefundAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *RefundAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.RefundList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.RefundList
+	return r0, notStubbed("RefundAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *RefundAPI) ListNWithParams(count int, offset int, params *paystack.RefundListParams) (*paystack.RefundList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.RefundList
+	return r0, notStubbed("RefundAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *RefundAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.RefundListParams) (*paystack.RefundList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.RefundList
+	return r0, notStubbed("RefundAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *RefundAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Refund] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Refund](notStubbed("RefundAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *RefundAPI) ListAllWithParams(ctx context.Context, params *paystack.RefundListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Refund] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.Refund](notStubbed("RefundAPI.ListAllWithParams"))
+}
+
+// DisputeAPI is a mock paystack.DisputeAPI
+type DisputeAPI struct {
+	Recorder
+
+	ListFunc                         func() (*paystack.DisputeList, error)
+	ListWithContextFunc              func(ctx context.Context) (*paystack.DisputeList, error)
+	ListNFunc                        func(count int, offset int) (*paystack.DisputeList, error)
+	ListNWithContextFunc             func(ctx context.Context, count int, offset int) (*paystack.DisputeList, error)
+	ListNWithParamsFunc              func(count int, offset int, params *paystack.DisputeListParams) (*paystack.DisputeList, error)
+	ListNWithParamsWithContextFunc   func(ctx context.Context, count int, offset int, params *paystack.DisputeListParams) (*paystack.DisputeList, error)
+	ListAllFunc                      func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Dispute]
+	ListAllWithParamsFunc            func(ctx context.Context, params *paystack.DisputeListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Dispute]
+	GetFunc                          func(id int) (*paystack.Dispute, error)
+	GetWithContextFunc               func(ctx context.Context, id int) (*paystack.Dispute, error)
+	ListByTransactionFunc            func(transactionID int) ([]paystack.Dispute, error)
+	ListByTransactionWithContextFunc func(ctx context.Context, transactionID int) ([]paystack.Dispute, error)
+	UpdateFunc                       func(id int, update *paystack.DisputeUpdate) (*paystack.Dispute, error)
+	UpdateWithContextFunc            func(ctx context.Context, id int, update *paystack.DisputeUpdate) (*paystack.Dispute, error)
+	AddEvidenceFunc                  func(id int, evidence *paystack.DisputeEvidence) (*paystack.DisputeEvidence, error)
+	AddEvidenceWithContextFunc       func(ctx context.Context, id int, evidence *paystack.DisputeEvidence) (*paystack.DisputeEvidence, error)
+	UploadURLFunc                    func(id int, filename string) (*paystack.DisputeUploadURL, error)
+	UploadURLWithContextFunc         func(ctx context.Context, id int, filename string) (*paystack.DisputeUploadURL, error)
+	ResolveFunc                      func(id int, resolution *paystack.DisputeResolution) (*paystack.Dispute, error)
+	ResolveWithContextFunc           func(ctx context.Context, id int, resolution *paystack.DisputeResolution) (*paystack.Dispute, error)
+	ExportFunc                       func(params *paystack.DisputeListParams) (*paystack.ExportResult, error)
+	ExportWithContextFunc            func(ctx context.Context, params *paystack.DisputeListParams) (*paystack.ExportResult, error)
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *DisputeAPI) List() (*paystack.DisputeList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.DisputeList
+	return r0, notStubbed("DisputeAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *DisputeAPI) ListWithContext(ctx context.Context) (*paystack.DisputeList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.DisputeList
+	return r0, notStubbed("DisputeAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *DisputeAPI) ListN(count int, offset int) (*paystack.DisputeList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.DisputeList
+	return r0, notStubbed("DisputeAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *DisputeAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.DisputeList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.DisputeList
+	return r0, notStubbed("DisputeAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *DisputeAPI) ListNWithParams(count int, offset int, params *paystack.DisputeListParams) (*paystack.DisputeList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.DisputeList
+	return r0, notStubbed("DisputeAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *DisputeAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.DisputeListParams) (*paystack.DisputeList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.DisputeList
+	return r0, notStubbed("DisputeAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *DisputeAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Dispute] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Dispute](notStubbed("DisputeAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *DisputeAPI) ListAllWithParams(ctx context.Context, params *paystack.DisputeListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Dispute] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.Dispute](notStubbed("DisputeAPI.ListAllWithParams"))
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *DisputeAPI) Get(id int) (*paystack.Dispute, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.Dispute
+	return r0, notStubbed("DisputeAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *DisputeAPI) GetWithContext(ctx context.Context, id int) (*paystack.Dispute, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.Dispute
+	return r0, notStubbed("DisputeAPI.GetWithContext")
+}
+
+// ListByTransaction records the call and runs ListByTransactionFunc,
+// or ListByTransactionWithContextFunc with a background context
+func (m *DisputeAPI) ListByTransaction(transactionID int) ([]paystack.Dispute, error) {
+	m.record("ListByTransaction", transactionID)
+	if m.ListByTransactionFunc != nil {
+		return m.ListByTransactionFunc(transactionID)
+	}
+	if m.ListByTransactionWithContextFunc != nil {
+		return m.ListByTransactionWithContextFunc(context.Background(), transactionID)
+	}
+	var r0 []paystack.Dispute
+	return r0, notStubbed("DisputeAPI.ListByTransaction")
+}
+
+// ListByTransactionWithContext records the call and runs ListByTransactionWithContextFunc
+func (m *DisputeAPI) ListByTransactionWithContext(ctx context.Context, transactionID int) ([]paystack.Dispute, error) {
+	m.record("ListByTransactionWithContext", transactionID)
+	if m.ListByTransactionWithContextFunc != nil {
+		return m.ListByTransactionWithContextFunc(ctx, transactionID)
+	}
+	var r0 []paystack.Dispute
+	return r0, notStubbed("DisputeAPI.ListByTransactionWithContext")
+}
+
+// Update records the call and runs UpdateFunc,
+// or UpdateWithContextFunc with a background context
+func (m *DisputeAPI) Update(id int, update *paystack.DisputeUpdate) (*paystack.Dispute, error) {
+	m.record("Update", id, update)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(id, update)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), id, update)
+	}
+	var r0 *paystack.Dispute
+	return r0, notStubbed("DisputeAPI.Update")
+}
+
+// UpdateWithContext records the call and runs UpdateWithContextFunc
+func (m *DisputeAPI) UpdateWithContext(ctx context.Context, id int, update *paystack.DisputeUpdate) (*paystack.Dispute, error) {
+	m.record("UpdateWithContext", id, update)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, id, update)
+	}
+	var r0 *paystack.Dispute
+	return r0, notStubbed("DisputeAPI.UpdateWithContext")
+}
+
+// AddEvidence records the call and runs AddEvidenceFunc,
+// or AddEvidenceWithContextFunc with a background context
+func (m *DisputeAPI) AddEvidence(id int, evidence *paystack.DisputeEvidence) (*paystack.DisputeEvidence, error) {
+	m.record("AddEvidence", id, evidence)
+	if m.AddEvidenceFunc != nil {
+		return m.AddEvidenceFunc(id, evidence)
+	}
+	if m.AddEvidenceWithContextFunc != nil {
+		return m.AddEvidenceWithContextFunc(context.Background(), id, evidence)
+	}
+	var r0 *paystack.DisputeEvidence
+	return r0, notStubbed("DisputeAPI.AddEvidence")
+}
+
+// AddEvidenceWithContext records the call and runs AddEvidenceWithContextFunc
+func (m *DisputeAPI) AddEvidenceWithContext(ctx context.Context, id int, evidence *paystack.DisputeEvidence) (*paystack.DisputeEvidence, error) {
+	m.record("AddEvidenceWithContext", id, evidence)
+	if m.AddEvidenceWithContextFunc != nil {
+		return m.AddEvidenceWithContextFunc(ctx, id, evidence)
+	}
+	var r0 *paystack.DisputeEvidence
+	return r0, notStubbed("DisputeAPI.AddEvidenceWithContext")
+}
+
+// UploadURL records the call and runs UploadURLFunc,
+// or UploadURLWithContextFunc with a background context
+func (m *DisputeAPI) UploadURL(id int, filename string) (*paystack.DisputeUploadURL, error) {
+	m.record("UploadURL", id, filename)
+	if m.UploadURLFunc != nil {
+		return m.UploadURLFunc(id, filename)
+	}
+	if m.UploadURLWithContextFunc != nil {
+		return m.UploadURLWithContextFunc(context.Background(), id, filename)
+	}
+	var r0 *paystack.DisputeUploadURL
+	return r0, notStubbed("DisputeAPI.UploadURL")
+}
+
+// UploadURLWithContext records the call and runs UploadURLWithContextFunc
+func (m *DisputeAPI) UploadURLWithContext(ctx context.Context, id int, filename string) (*paystack.DisputeUploadURL, error) {
+	m.record("UploadURLWithContext", id, filename)
+	if m.UploadURLWithContextFunc != nil {
+		return m.UploadURLWithContextFunc(ctx, id, filename)
+	}
+	var r0 *paystack.DisputeUploadURL
+	return r0, notStubbed("DisputeAPI.UploadURLWithContext")
+}
+
+// Resolve records the call and runs ResolveFunc,
+// or ResolveWithContextFunc with a background context
+func (m *DisputeAPI) Resolve(id int, resolution *paystack.DisputeResolution) (*paystack.Dispute, error) {
+	m.record("Resolve", id, resolution)
+	if m.ResolveFunc != nil {
+		return m.ResolveFunc(id, resolution)
+	}
+	if m.ResolveWithContextFunc != nil {
+		return m.ResolveWithContextFunc(context.Background(), id, resolution)
+	}
+	var r0 *paystack.Dispute
+	return r0, notStubbed("DisputeAPI.Resolve")
+}
+
+// ResolveWithContext records the call and runs ResolveWithContextFunc
+func (m *DisputeAPI) ResolveWithContext(ctx context.Context, id int, resolution *paystack.DisputeResolution) (*paystack.Dispute, error) {
+	m.record("ResolveWithContext", id, resolution)
+	if m.ResolveWithContextFunc != nil {
+		return m.ResolveWithContextFunc(ctx, id, resolution)
+	}
+	var r0 *paystack.Dispute
+	return r0, notStubbed("DisputeAPI.ResolveWithContext")
+}
+
+// Export records the call and runs ExportFunc,
+// or ExportWithContextFunc with a background context
+func (m *DisputeAPI) Export(params *paystack.DisputeListParams) (*paystack.ExportResult, error) {
+	m.record("Export", params)
+	if m.ExportFunc != nil {
+		return m.ExportFunc(params)
+	}
+	if m.ExportWithContextFunc != nil {
+		return m.ExportWithContextFunc(context.Background(), params)
+	}
+	var r0 *paystack.ExportResult
+	return r0, notStubbed("DisputeAPI.Export")
+}
+
+// ExportWithContext records the call and runs ExportWithContextFunc
+func (m *DisputeAPI) ExportWithContext(ctx context.Context, params *paystack.DisputeListParams) (*paystack.ExportResult, error) {
+	m.record("ExportWithContext", params)
+	if m.ExportWithContextFunc != nil {
+		return m.ExportWithContextFunc(ctx, params)
+	}
+	var r0 *paystack.ExportResult
+	return r0, notStubbed("DisputeAPI.ExportWithContext")
+}
+
+// DedicatedAccountAPI is a mock paystack.DedicatedAccountAPI
+type DedicatedAccountAPI struct {
+	Recorder
+
+	CreateFunc                        func(req *paystack.DedicatedAccountRequest) (*paystack.DedicatedAccount, error)
+	CreateWithContextFunc             func(ctx context.Context, req *paystack.DedicatedAccountRequest) (*paystack.DedicatedAccount, error)
+	AssignFunc                        func(req *paystack.DedicatedAccountAssignRequest) (*paystack.DedicatedAccountAssignResult, error)
+	AssignWithContextFunc             func(ctx context.Context, req *paystack.DedicatedAccountAssignRequest) (*paystack.DedicatedAccountAssignResult, error)
+	GetFunc                           func(id int) (*paystack.DedicatedAccount, error)
+	GetWithContextFunc                func(ctx context.Context, id int) (*paystack.DedicatedAccount, error)
+	ListFunc                          func() (*paystack.DedicatedAccountList, error)
+	ListWithContextFunc               func(ctx context.Context) (*paystack.DedicatedAccountList, error)
+	ListNFunc                         func(count int, offset int) (*paystack.DedicatedAccountList, error)
+	ListNWithContextFunc              func(ctx context.Context, count int, offset int) (*paystack.DedicatedAccountList, error)
+	ListNWithParamsFunc               func(count int, offset int, params *paystack.DedicatedAccountListParams) (*paystack.DedicatedAccountList, error)
+	ListNWithParamsWithContextFunc    func(ctx context.Context, count int, offset int, params *paystack.DedicatedAccountListParams) (*paystack.DedicatedAccountList, error)
+	ListAllFunc                       func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.DedicatedAccount]
+	ListAllWithParamsFunc             func(ctx context.Context, params *paystack.DedicatedAccountListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.DedicatedAccount]
+	RequeryFunc                       func(accountNumber string, providerSlug string, date time.Time) (*paystack.DedicatedAccountRequeryResult, error)
+	RequeryWithContextFunc            func(ctx context.Context, accountNumber string, providerSlug string, date time.Time) (*paystack.DedicatedAccountRequeryResult, error)
+	DeactivateFunc                    func(id int) (*paystack.DedicatedAccount, error)
+	DeactivateWithContextFunc         func(ctx context.Context, id int) (*paystack.DedicatedAccount, error)
+	AddSplitFunc                      func(req *paystack.DedicatedAccountRequest) (*paystack.DedicatedAccount, error)
+	AddSplitWithContextFunc           func(ctx context.Context, req *paystack.DedicatedAccountRequest) (*paystack.DedicatedAccount, error)
+	RemoveSplitFunc                   func(accountNumber string) (*paystack.DedicatedAccount, error)
+	RemoveSplitWithContextFunc        func(ctx context.Context, accountNumber string) (*paystack.DedicatedAccount, error)
+	AvailableProvidersFunc            func() ([]paystack.DedicatedAccountProvider, error)
+	AvailableProvidersWithContextFunc func(ctx context.Context) ([]paystack.DedicatedAccountProvider, error)
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *DedicatedAccountAPI) Create(req *paystack.DedicatedAccountRequest) (*paystack.DedicatedAccount, error) {
+	m.record("Create", req)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(req)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *DedicatedAccountAPI) CreateWithContext(ctx context.Context, req *paystack.DedicatedAccountRequest) (*paystack.DedicatedAccount, error) {
+	m.record("CreateWithContext", req)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.CreateWithContext")
+}
+
+// Assign records the call and runs AssignFunc,
+// or AssignWithContextFunc with a background context
+func (m *DedicatedAccountAPI) Assign(req *paystack.DedicatedAccountAssignRequest) (*paystack.DedicatedAccountAssignResult, error) {
+	m.record("Assign", req)
+	if m.AssignFunc != nil {
+		return m.AssignFunc(req)
+	}
+	if m.AssignWithContextFunc != nil {
+		return m.AssignWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.DedicatedAccountAssignResult
+	return r0, notStubbed("DedicatedAccountAPI.Assign")
+}
+
+// AssignWithContext records the call and runs AssignWithContextFunc
+func (m *DedicatedAccountAPI) AssignWithContext(ctx context.Context, req *paystack.DedicatedAccountAssignRequest) (*paystack.DedicatedAccountAssignResult, error) {
+	m.record("AssignWithContext", req)
+	if m.AssignWithContextFunc != nil {
+		return m.AssignWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.DedicatedAccountAssignResult
+	return r0, notStubbed("DedicatedAccountAPI.AssignWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *DedicatedAccountAPI) Get(id int) (*paystack.DedicatedAccount, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *DedicatedAccountAPI) GetWithContext(ctx context.Context, id int) (*paystack.DedicatedAccount, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *DedicatedAccountAPI) List() (*paystack.DedicatedAccountList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.DedicatedAccountList
+	return r0, notStubbed("DedicatedAccountAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *DedicatedAccountAPI) ListWithContext(ctx context.Context) (*paystack.DedicatedAccountList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.DedicatedAccountList
+	return r0, notStubbed("DedicatedAccountAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *DedicatedAccountAPI) ListN(count int, offset int) (*paystack.DedicatedAccountList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.DedicatedAccountList
+	return r0, notStubbed("DedicatedAccountAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *DedicatedAccountAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.DedicatedAccountList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.DedicatedAccountList
+	return r0, notStubbed("DedicatedAccountAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *DedicatedAccountAPI) ListNWithParams(count int, offset int, params *paystack.DedicatedAccountListParams) (*paystack.DedicatedAccountList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.DedicatedAccountList
+	return r0, notStubbed("DedicatedAccountAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *DedicatedAccountAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.DedicatedAccountListParams) (*paystack.DedicatedAccountList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.DedicatedAccountList
+	return r0, notStubbed("DedicatedAccountAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *DedicatedAccountAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.DedicatedAccount] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.DedicatedAccount](notStubbed("DedicatedAccountAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *DedicatedAccountAPI) ListAllWithParams(ctx context.Context, params *paystack.DedicatedAccountListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.DedicatedAccount] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.DedicatedAccount](notStubbed("DedicatedAccountAPI.ListAllWithParams"))
+}
+
+// Requery records the call and runs RequeryFunc,
+// or RequeryWithContextFunc with a background context
+func (m *DedicatedAccountAPI) Requery(accountNumber string, providerSlug string, date time.Time) (*paystack.DedicatedAccountRequeryResult, error) {
+	m.record("Requery", accountNumber, providerSlug, date)
+	if m.RequeryFunc != nil {
+		return m.RequeryFunc(accountNumber, providerSlug, date)
+	}
+	if m.RequeryWithContextFunc != nil {
+		return m.RequeryWithContextFunc(context.Background(), accountNumber, providerSlug, date)
+	}
+	var r0 *paystack.DedicatedAccountRequeryResult
+	return r0, notStubbed("DedicatedAccountAPI.Requery")
+}
+
+// RequeryWithContext records the call and runs RequeryWithContextFunc
+func (m *DedicatedAccountAPI) RequeryWithContext(ctx context.Context, accountNumber string, providerSlug string, date time.Time) (*paystack.DedicatedAccountRequeryResult, error) {
+	m.record("RequeryWithContext", accountNumber, providerSlug, date)
+	if m.RequeryWithContextFunc != nil {
+		return m.RequeryWithContextFunc(ctx, accountNumber, providerSlug, date)
+	}
+	var r0 *paystack.DedicatedAccountRequeryResult
+	return r0, notStubbed("DedicatedAccountAPI.RequeryWithContext")
+}
+
+// Deactivate records the call and runs DeactivateFunc,
+// or DeactivateWithContextFunc with a background context
+func (m *DedicatedAccountAPI) Deactivate(id int) (*paystack.DedicatedAccount, error) {
+	m.record("Deactivate", id)
+	if m.DeactivateFunc != nil {
+		return m.DeactivateFunc(id)
+	}
+	if m.DeactivateWithContextFunc != nil {
+		return m.DeactivateWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.Deactivate")
+}
+
+// DeactivateWithContext records the call and runs DeactivateWithContextFunc
+func (m *DedicatedAccountAPI) DeactivateWithContext(ctx context.Context, id int) (*paystack.DedicatedAccount, error) {
+	m.record("DeactivateWithContext", id)
+	if m.DeactivateWithContextFunc != nil {
+		return m.DeactivateWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.DeactivateWithContext")
+}
+
+// AddSplit records the call and runs AddSplitFunc,
+// or AddSplitWithContextFunc with a background context
+func (m *DedicatedAccountAPI) AddSplit(req *paystack.DedicatedAccountRequest) (*paystack.DedicatedAccount, error) {
+	m.record("AddSplit", req)
+	if m.AddSplitFunc != nil {
+		return m.AddSplitFunc(req)
+	}
+	if m.AddSplitWithContextFunc != nil {
+		return m.AddSplitWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.AddSplit")
+}
+
+// AddSplitWithContext records the call and runs AddSplitWithContextFunc
+func (m *DedicatedAccountAPI) AddSplitWithContext(ctx context.Context, req *paystack.DedicatedAccountRequest) (*paystack.DedicatedAccount, error) {
+	m.record("AddSplitWithContext", req)
+	if m.AddSplitWithContextFunc != nil {
+		return m.AddSplitWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.AddSplitWithContext")
+}
+
+// RemoveSplit records the call and runs RemoveSplitFunc,
+// or RemoveSplitWithContextFunc with a background context
+func (m *DedicatedAccountAPI) RemoveSplit(accountNumber string) (*paystack.DedicatedAccount, error) {
+	m.record("RemoveSplit", accountNumber)
+	if m.RemoveSplitFunc != nil {
+		return m.RemoveSplitFunc(accountNumber)
+	}
+	if m.RemoveSplitWithContextFunc != nil {
+		return m.RemoveSplitWithContextFunc(context.Background(), accountNumber)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.RemoveSplit")
+}
+
+// RemoveSplitWithContext records the call and runs RemoveSplitWithContextFunc
+func (m *DedicatedAccountAPI) RemoveSplitWithContext(ctx context.Context, accountNumber string) (*paystack.DedicatedAccount, error) {
+	m.record("RemoveSplitWithContext", accountNumber)
+	if m.RemoveSplitWithContextFunc != nil {
+		return m.RemoveSplitWithContextFunc(ctx, accountNumber)
+	}
+	var r0 *paystack.DedicatedAccount
+	return r0, notStubbed("DedicatedAccountAPI.RemoveSplitWithContext")
+}
+
+// AvailableProviders records the call and runs AvailableProvidersFunc,
+// or AvailableProvidersWithContextFunc with a background context
+func (m *DedicatedAccountAPI) AvailableProviders() ([]paystack.DedicatedAccountProvider, error) {
+	m.record("AvailableProviders")
+	if m.AvailableProvidersFunc != nil {
+		return m.AvailableProvidersFunc()
+	}
+	if m.AvailableProvidersWithContextFunc != nil {
+		return m.AvailableProvidersWithContextFunc(context.Background())
+	}
+	var r0 []paystack.DedicatedAccountProvider
+	return r0, notStubbed("DedicatedAccountAPI.AvailableProviders")
+}
+
+// AvailableProvidersWithContext records the call and runs AvailableProvidersWithContextFunc
+func (m *DedicatedAccountAPI) AvailableProvidersWithContext(ctx context.Context) ([]paystack.DedicatedAccountProvider, error) {
+	m.record("AvailableProvidersWithContext")
+	if m.AvailableProvidersWithContextFunc != nil {
+		return m.AvailableProvidersWithContextFunc(ctx)
+	}
+	var r0 []paystack.DedicatedAccountProvider
+	return r0, notStubbed("DedicatedAccountAPI.AvailableProvidersWithContext")
+}
+
+// PaymentRequestAPI is a mock paystack.PaymentRequestAPI
+type PaymentRequestAPI struct {
+	Recorder
+
+	CreateFunc                     func(req *paystack.PaymentRequestRequest) (*paystack.PaymentRequest, error)
+	CreateWithContextFunc          func(ctx context.Context, req *paystack.PaymentRequestRequest) (*paystack.PaymentRequest, error)
+	UpdateFunc                     func(idOrCode string, req *paystack.PaymentRequestRequest) (*paystack.PaymentRequest, error)
+	UpdateWithContextFunc          func(ctx context.Context, idOrCode string, req *paystack.PaymentRequestRequest) (*paystack.PaymentRequest, error)
+	GetFunc                        func(idOrCode string) (*paystack.PaymentRequest, error)
+	GetWithContextFunc             func(ctx context.Context, idOrCode string) (*paystack.PaymentRequest, error)
+	VerifyFunc                     func(code string) (*paystack.PaymentRequest, error)
+	VerifyWithContextFunc          func(ctx context.Context, code string) (*paystack.PaymentRequest, error)
+	ListFunc                       func() (*paystack.PaymentRequestList, error)
+	ListWithContextFunc            func(ctx context.Context) (*paystack.PaymentRequestList, error)
+	ListNFunc                      func(count int, offset int) (*paystack.PaymentRequestList, error)
+	ListNWithContextFunc           func(ctx context.Context, count int, offset int) (*paystack.PaymentRequestList, error)
+	ListNWithParamsFunc            func(count int, offset int, params *paystack.PaymentRequestListParams) (*paystack.PaymentRequestList, error)
+	ListNWithParamsWithContextFunc func(ctx context.Context, count int, offset int, params *paystack.PaymentRequestListParams) (*paystack.PaymentRequestList, error)
+	ListAllFunc                    func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.PaymentRequest]
+	ListAllWithParamsFunc          func(ctx context.Context, params *paystack.PaymentRequestListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.PaymentRequest]
+	NotifyFunc                     func(code string) (paystack.Response, error)
+	NotifyWithContextFunc          func(ctx context.Context, code string) (paystack.Response, error)
+	TotalsFunc                     func() (*paystack.PaymentRequestTotals, error)
+	TotalsWithContextFunc          func(ctx context.Context) (*paystack.PaymentRequestTotals, error)
+	FinalizeFunc                   func(code string, sendNotification bool) (*paystack.PaymentRequest, error)
+	FinalizeWithContextFunc        func(ctx context.Context, code string, sendNotification bool) (*paystack.PaymentRequest, error)
+	ArchiveFunc                    func(code string) (paystack.Response, error)
+	ArchiveWithContextFunc         func(ctx context.Context, code string) (paystack.Response, error)
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *PaymentRequestAPI) Create(req *paystack.PaymentRequestRequest) (*paystack.PaymentRequest, error) {
+	m.record("Create", req)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(req)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *PaymentRequestAPI) CreateWithContext(ctx context.Context, req *paystack.PaymentRequestRequest) (*paystack.PaymentRequest, error) {
+	m.record("CreateWithContext", req)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.CreateWithContext")
+}
+
+// Update records the call and runs UpdateFunc,
+// or UpdateWithContextFunc with a background context
+func (m *PaymentRequestAPI) Update(idOrCode string, req *paystack.PaymentRequestRequest) (*paystack.PaymentRequest, error) {
+	m.record("Update", idOrCode, req)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(idOrCode, req)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), idOrCode, req)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.Update")
+}
+
+// UpdateWithContext records the call and runs UpdateWithContextFunc
+func (m *PaymentRequestAPI) UpdateWithContext(ctx context.Context, idOrCode string, req *paystack.PaymentRequestRequest) (*paystack.PaymentRequest, error) {
+	m.record("UpdateWithContext", idOrCode, req)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, idOrCode, req)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.UpdateWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *PaymentRequestAPI) Get(idOrCode string) (*paystack.PaymentRequest, error) {
+	m.record("Get", idOrCode)
+	if m.GetFunc != nil {
+		return m.GetFunc(idOrCode)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), idOrCode)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *PaymentRequestAPI) GetWithContext(ctx context.Context, idOrCode string) (*paystack.PaymentRequest, error) {
+	m.record("GetWithContext", idOrCode)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, idOrCode)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.GetWithContext")
+}
+
+// Verify records the call and runs VerifyFunc,
+// or VerifyWithContextFunc with a background context
+func (m *PaymentRequestAPI) Verify(code string) (*paystack.PaymentRequest, error) {
+	m.record("Verify", code)
+	if m.VerifyFunc != nil {
+		return m.VerifyFunc(code)
+	}
+	if m.VerifyWithContextFunc != nil {
+		return m.VerifyWithContextFunc(context.Background(), code)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.Verify")
+}
+
+// VerifyWithContext records the call and runs VerifyWithContextFunc
+func (m *PaymentRequestAPI) VerifyWithContext(ctx context.Context, code string) (*paystack.PaymentRequest, error) {
+	m.record("VerifyWithContext", code)
+	if m.VerifyWithContextFunc != nil {
+		return m.VerifyWithContextFunc(ctx, code)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.VerifyWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *PaymentRequestAPI) List() (*paystack.PaymentRequestList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.PaymentRequestList
+	return r0, notStubbed("PaymentRequestAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *PaymentRequestAPI) ListWithContext(ctx context.Context) (*paystack.PaymentRequestList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.PaymentRequestList
+	return r0, notStubbed("PaymentRequestAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *PaymentRequestAPI) ListN(count int, offset int) (*paystack.PaymentRequestList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.PaymentRequestList
+	return r0, notStubbed("PaymentRequestAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *PaymentRequestAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.PaymentRequestList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.PaymentRequestList
+	return r0, notStubbed("PaymentRequestAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *PaymentRequestAPI) ListNWithParams(count int, offset int, params *paystack.PaymentRequestListParams) (*paystack.PaymentRequestList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.PaymentRequestList
+	return r0, notStubbed("PaymentRequestAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *PaymentRequestAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.PaymentRequestListParams) (*paystack.PaymentRequestList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.PaymentRequestList
+	return r0, notStubbed("PaymentRequestAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *PaymentRequestAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.PaymentRequest] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.PaymentRequest](notStubbed("PaymentRequestAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *PaymentRequestAPI) ListAllWithParams(ctx context.Context, params *paystack.PaymentRequestListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.PaymentRequest] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.PaymentRequest](notStubbed("PaymentRequestAPI.ListAllWithParams"))
+}
+
+// Notify records the call and runs NotifyFunc,
+// or NotifyWithContextFunc with a background context
+func (m *PaymentRequestAPI) Notify(code string) (paystack.Response, error) {
+	m.record("Notify", code)
+	if m.NotifyFunc != nil {
+		return m.NotifyFunc(code)
+	}
+	if m.NotifyWithContextFunc != nil {
+		return m.NotifyWithContextFunc(context.Background(), code)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("PaymentRequestAPI.Notify")
+}
+
+// NotifyWithContext records the call and runs NotifyWithContextFunc
+func (m *PaymentRequestAPI) NotifyWithContext(ctx context.Context, code string) (paystack.Response, error) {
+	m.record("NotifyWithContext", code)
+	if m.NotifyWithContextFunc != nil {
+		return m.NotifyWithContextFunc(ctx, code)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("PaymentRequestAPI.NotifyWithContext")
+}
+
+// Totals records the call and runs TotalsFunc,
+// or TotalsWithContextFunc with a background context
+func (m *PaymentRequestAPI) Totals() (*paystack.PaymentRequestTotals, error) {
+	m.record("Totals")
+	if m.TotalsFunc != nil {
+		return m.TotalsFunc()
+	}
+	if m.TotalsWithContextFunc != nil {
+		return m.TotalsWithContextFunc(context.Background())
+	}
+	var r0 *paystack.PaymentRequestTotals
+	return r0, notStubbed("PaymentRequestAPI.Totals")
+}
+
+// TotalsWithContext records the call and runs TotalsWithContextFunc
+func (m *PaymentRequestAPI) TotalsWithContext(ctx context.Context) (*paystack.PaymentRequestTotals, error) {
+	m.record("TotalsWithContext")
+	if m.TotalsWithContextFunc != nil {
+		return m.TotalsWithContextFunc(ctx)
+	}
+	var r0 *paystack.PaymentRequestTotals
+	return r0, notStubbed("PaymentRequestAPI.TotalsWithContext")
+}
+
+// Finalize records the call and runs FinalizeFunc,
+// or FinalizeWithContextFunc with a background context
+func (m *PaymentRequestAPI) Finalize(code string, sendNotification bool) (*paystack.PaymentRequest, error) {
+	m.record("Finalize", code, sendNotification)
+	if m.FinalizeFunc != nil {
+		return m.FinalizeFunc(code, sendNotification)
+	}
+	if m.FinalizeWithContextFunc != nil {
+		return m.FinalizeWithContextFunc(context.Background(), code, sendNotification)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.Finalize")
+}
+
+// FinalizeWithContext records the call and runs FinalizeWithContextFunc
+func (m *PaymentRequestAPI) FinalizeWithContext(ctx context.Context, code string, sendNotification bool) (*paystack.PaymentRequest, error) {
+	m.record("FinalizeWithContext", code, sendNotification)
+	if m.FinalizeWithContextFunc != nil {
+		return m.FinalizeWithContextFunc(ctx, code, sendNotification)
+	}
+	var r0 *paystack.PaymentRequest
+	return r0, notStubbed("PaymentRequestAPI.FinalizeWithContext")
+}
+
+// Archive records the call and runs ArchiveFunc,
+// or ArchiveWithContextFunc with a background context
+func (m *PaymentRequestAPI) Archive(code string) (paystack.Response, error) {
+	m.record("Archive", code)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(code)
+	}
+	if m.ArchiveWithContextFunc != nil {
+		return m.ArchiveWithContextFunc(context.Background(), code)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("PaymentRequestAPI.Archive")
+}
+
+// ArchiveWithContext records the call and runs ArchiveWithContextFunc
+func (m *PaymentRequestAPI) ArchiveWithContext(ctx context.Context, code string) (paystack.Response, error) {
+	m.record("ArchiveWithContext", code)
+	if m.ArchiveWithContextFunc != nil {
+		return m.ArchiveWithContextFunc(ctx, code)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("PaymentRequestAPI.ArchiveWithContext")
+}
+
+// ProductAPI is a mock paystack.ProductAPI
+type ProductAPI struct {
+	Recorder
+
+	CreateFunc                     func(req *paystack.ProductRequest) (*paystack.Product, error)
+	CreateWithContextFunc          func(ctx context.Context, req *paystack.ProductRequest) (*paystack.Product, error)
+	UpdateFunc                     func(id int, req *paystack.ProductRequest) (*paystack.Product, error)
+	UpdateWithContextFunc          func(ctx context.Context, id int, req *paystack.ProductRequest) (*paystack.Product, error)
+	GetFunc                        func(id int) (*paystack.Product, error)
+	GetWithContextFunc             func(ctx context.Context, id int) (*paystack.Product, error)
+	ListFunc                       func() (*paystack.ProductList, error)
+	ListWithContextFunc            func(ctx context.Context) (*paystack.ProductList, error)
+	ListNFunc                      func(count int, offset int) (*paystack.ProductList, error)
+	ListNWithContextFunc           func(ctx context.Context, count int, offset int) (*paystack.ProductList, error)
+	ListNWithParamsFunc            func(count int, offset int, params *paystack.ProductListParams) (*paystack.ProductList, error)
+	ListNWithParamsWithContextFunc func(ctx context.Context, count int, offset int, params *paystack.ProductListParams) (*paystack.ProductList, error)
+	ListAllFunc                    func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Product]
+	ListAllWithParamsFunc          func(ctx context.Context, params *paystack.ProductListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Product]
+}
+
+// Create records the call and runs CreateFunc,
+// or CreateWithContextFunc with a background context
+func (m *ProductAPI) Create(req *paystack.ProductRequest) (*paystack.Product, error) {
+	m.record("Create", req)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(req)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), req)
+	}
+	var r0 *paystack.Product
+	return r0, notStubbed("ProductAPI.Create")
+}
+
+// CreateWithContext records the call and runs CreateWithContextFunc
+func (m *ProductAPI) CreateWithContext(ctx context.Context, req *paystack.ProductRequest) (*paystack.Product, error) {
+	m.record("CreateWithContext", req)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, req)
+	}
+	var r0 *paystack.Product
+	return r0, notStubbed("ProductAPI.CreateWithContext")
+}
+
+// Update records the call and runs UpdateFunc,
+// or UpdateWithContextFunc with a background context
+func (m *ProductAPI) Update(id int, req *paystack.ProductRequest) (*paystack.Product, error) {
+	m.record("Update", id, req)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(id, req)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), id, req)
+	}
+	var r0 *paystack.Product
+	return r0, notStubbed("ProductAPI.Update")
+}
+
+// UpdateWithContext records the call and runs UpdateWithContextFunc
+func (m *ProductAPI) UpdateWithContext(ctx context.Context, id int, req *paystack.ProductRequest) (*paystack.Product, error) {
+	m.record("UpdateWithContext", id, req)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, id, req)
+	}
+	var r0 *paystack.Product
+	return r0, notStubbed("ProductAPI.UpdateWithContext")
+}
+
+// Get records the call and runs GetFunc,
+// or GetWithContextFunc with a background context
+func (m *ProductAPI) Get(id int) (*paystack.Product, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), id)
+	}
+	var r0 *paystack.Product
+	return r0, notStubbed("ProductAPI.Get")
+}
+
+// GetWithContext records the call and runs GetWithContextFunc
+func (m *ProductAPI) GetWithContext(ctx context.Context, id int) (*paystack.Product, error) {
+	m.record("GetWithContext", id)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, id)
+	}
+	var r0 *paystack.Product
+	return r0, notStubbed("ProductAPI.GetWithContext")
+}
+
+// List records the call and runs ListFunc,
+// or ListWithContextFunc with a background context
+func (m *ProductAPI) List() (*paystack.ProductList, error) {
+	m.record("List")
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 *paystack.ProductList
+	return r0, notStubbed("ProductAPI.List")
+}
+
+// ListWithContext records the call and runs ListWithContextFunc
+func (m *ProductAPI) ListWithContext(ctx context.Context) (*paystack.ProductList, error) {
+	m.record("ListWithContext")
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	var r0 *paystack.ProductList
+	return r0, notStubbed("ProductAPI.ListWithContext")
+}
+
+// ListN records the call and runs ListNFunc,
+// or ListNWithContextFunc with a background context
+func (m *ProductAPI) ListN(count int, offset int) (*paystack.ProductList, error) {
+	m.record("ListN", count, offset)
+	if m.ListNFunc != nil {
+		return m.ListNFunc(count, offset)
+	}
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(context.Background(), count, offset)
+	}
+	var r0 *paystack.ProductList
+	return r0, notStubbed("ProductAPI.ListN")
+}
+
+// ListNWithContext records the call and runs ListNWithContextFunc
+func (m *ProductAPI) ListNWithContext(ctx context.Context, count int, offset int) (*paystack.ProductList, error) {
+	m.record("ListNWithContext", count, offset)
+	if m.ListNWithContextFunc != nil {
+		return m.ListNWithContextFunc(ctx, count, offset)
+	}
+	var r0 *paystack.ProductList
+	return r0, notStubbed("ProductAPI.ListNWithContext")
+}
+
+// ListNWithParams records the call and runs ListNWithParamsFunc,
+// or ListNWithParamsWithContextFunc with a background context
+func (m *ProductAPI) ListNWithParams(count int, offset int, params *paystack.ProductListParams) (*paystack.ProductList, error) {
+	m.record("ListNWithParams", count, offset, params)
+	if m.ListNWithParamsFunc != nil {
+		return m.ListNWithParamsFunc(count, offset, params)
+	}
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(context.Background(), count, offset, params)
+	}
+	var r0 *paystack.ProductList
+	return r0, notStubbed("ProductAPI.ListNWithParams")
+}
+
+// ListNWithParamsWithContext records the call and runs ListNWithParamsWithContextFunc
+func (m *ProductAPI) ListNWithParamsWithContext(ctx context.Context, count int, offset int, params *paystack.ProductListParams) (*paystack.ProductList, error) {
+	m.record("ListNWithParamsWithContext", count, offset, params)
+	if m.ListNWithParamsWithContextFunc != nil {
+		return m.ListNWithParamsWithContextFunc(ctx, count, offset, params)
+	}
+	var r0 *paystack.ProductList
+	return r0, notStubbed("ProductAPI.ListNWithParamsWithContext")
+}
+
+// ListAll records the call and runs ListAllFunc
+func (m *ProductAPI) ListAll(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Product] {
+	m.record("ListAll", opts)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opts)
+	}
+	return paystack.ErrorIterator[paystack.Product](notStubbed("ProductAPI.ListAll"))
+}
+
+// ListAllWithParams records the call and runs ListAllWithParamsFunc
+func (m *ProductAPI) ListAllWithParams(ctx context.Context, params *paystack.ProductListParams, opts *paystack.ListOptions) *paystack.Iterator[paystack.Product] {
+	m.record("ListAllWithParams", params, opts)
+	if m.ListAllWithParamsFunc != nil {
+		return m.ListAllWithParamsFunc(ctx, params, opts)
+	}
+	return paystack.ErrorIterator[paystack.Product](notStubbed("ProductAPI.ListAllWithParams"))
+}
+
+// Client is a mock paystack.API whose services are mocks too.
+// Use NewClient to create one with every service set.
+type Client struct {
+	Recorder
+
+	Customer         *CustomerAPI
+	Transaction      *TransactionAPI
+	SubAccount       *SubAccountAPI
+	Plan             *PlanAPI
+	Subscription     *SubscriptionAPI
+	Page             *PageAPI
+	Settlement       *SettlementAPI
+	Transfer         *TransferAPI
+	Charge           *ChargeAPI
+	Bank             *BankAPI
+	BulkCharge       *BulkChargeAPI
+	Refund           *RefundAPI
+	Dispute          *DisputeAPI
+	DedicatedAccount *DedicatedAccountAPI
+	PaymentRequest   *PaymentRequestAPI
+	Product          *ProductAPI
+
+	CallFunc                            func(method string, path string, body interface{}, v interface{}) error
+	CallContextFunc                     func(ctx context.Context, method string, path string, body interface{}, v interface{}) error
+	ResolveCardBINFunc                  func(bin int) (paystack.Response, error)
+	ResolveCardBINWithContextFunc       func(ctx context.Context, bin int) (paystack.Response, error)
+	CheckBalanceFunc                    func() ([]paystack.Balance, error)
+	CheckBalanceWithContextFunc         func(ctx context.Context) ([]paystack.Balance, error)
+	GetSessionTimeoutFunc               func() (*paystack.SessionTimeout, error)
+	GetSessionTimeoutWithContextFunc    func(ctx context.Context) (*paystack.SessionTimeout, error)
+	UpdateSessionTimeoutFunc            func(timeout int) (*paystack.SessionTimeout, error)
+	UpdateSessionTimeoutWithContextFunc func(ctx context.Context, timeout int) (*paystack.SessionTimeout, error)
+}
+
+// Customers returns the CustomerAPI mock
+func (m *Client) Customers() paystack.CustomerAPI {
+	return m.Customer
+}
+
+// Transactions returns the TransactionAPI mock
+func (m *Client) Transactions() paystack.TransactionAPI {
+	return m.Transaction
+}
+
+// SubAccounts returns the SubAccountAPI mock
+func (m *Client) SubAccounts() paystack.SubAccountAPI {
+	return m.SubAccount
+}
+
+// Plans returns the PlanAPI mock
+func (m *Client) Plans() paystack.PlanAPI {
+	return m.Plan
+}
+
+// Subscriptions returns the SubscriptionAPI mock
+func (m *Client) Subscriptions() paystack.SubscriptionAPI {
+	return m.Subscription
+}
+
+// Pages returns the PageAPI mock
+func (m *Client) Pages() paystack.PageAPI {
+	return m.Page
+}
+
+// Settlements returns the SettlementAPI mock
+func (m *Client) Settlements() paystack.SettlementAPI {
+	return m.Settlement
+}
+
+// Transfers returns the TransferAPI mock
+func (m *Client) Transfers() paystack.TransferAPI {
+	return m.Transfer
+}
+
+// Charges returns the ChargeAPI mock
+func (m *Client) Charges() paystack.ChargeAPI {
+	return m.Charge
+}
+
+// Banks returns the BankAPI mock
+func (m *Client) Banks() paystack.BankAPI {
+	return m.Bank
+}
+
+// BulkCharges returns the BulkChargeAPI mock
+func (m *Client) BulkCharges() paystack.BulkChargeAPI {
+	return m.BulkCharge
+}
+
+// Refunds returns the RefundAPI mock
+func (m *Client) Refunds() paystack.RefundAPI {
+	return m.Refund
+}
+
+// Disputes returns the DisputeAPI mock
+func (m *Client) Disputes() paystack.DisputeAPI {
+	return m.Dispute
+}
+
+// DedicatedAccounts returns the DedicatedAccountAPI mock
+func (m *Client) DedicatedAccounts() paystack.DedicatedAccountAPI {
+	return m.DedicatedAccount
+}
+
+// PaymentRequests returns the PaymentRequestAPI mock
+func (m *Client) PaymentRequests() paystack.PaymentRequestAPI {
+	return m.PaymentRequest
+}
+
+// Products returns the ProductAPI mock
+func (m *Client) Products() paystack.ProductAPI {
+	return m.Product
+}
+
+// Call records the call and runs CallFunc
+func (m *Client) Call(method string, path string, body interface{}, v interface{}) error {
+	m.record("Call", method, path, body, v)
+	if m.CallFunc != nil {
+		return m.CallFunc(method, path, body, v)
+	}
+	return notStubbed("Client.Call")
+}
+
+// CallContext records the call and runs CallContextFunc
+func (m *Client) CallContext(ctx context.Context, method string, path string, body interface{}, v interface{}) error {
+	m.record("CallContext", method, path, body, v)
+	if m.CallContextFunc != nil {
+		return m.CallContextFunc(ctx, method, path, body, v)
+	}
+	return notStubbed("Client.CallContext")
+}
+
+// ResolveCardBIN records the call and runs ResolveCardBINFunc,
+// or ResolveCardBINWithContextFunc with a background context
+func (m *Client) ResolveCardBIN(bin int) (paystack.Response, error) {
+	m.record("ResolveCardBIN", bin)
+	if m.ResolveCardBINFunc != nil {
+		return m.ResolveCardBINFunc(bin)
+	}
+	if m.ResolveCardBINWithContextFunc != nil {
+		return m.ResolveCardBINWithContextFunc(context.Background(), bin)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("Client.ResolveCardBIN")
+}
+
+// ResolveCardBINWithContext records the call and runs ResolveCardBINWithContextFunc
+func (m *Client) ResolveCardBINWithContext(ctx context.Context, bin int) (paystack.Response, error) {
+	m.record("ResolveCardBINWithContext", bin)
+	if m.ResolveCardBINWithContextFunc != nil {
+		return m.ResolveCardBINWithContextFunc(ctx, bin)
+	}
+	var r0 paystack.Response
+	return r0, notStubbed("Client.ResolveCardBINWithContext")
+}
+
+// CheckBalance records the call and runs CheckBalanceFunc,
+// or CheckBalanceWithContextFunc with a background context
+func (m *Client) CheckBalance() ([]paystack.Balance, error) {
+	m.record("CheckBalance")
+	if m.CheckBalanceFunc != nil {
+		return m.CheckBalanceFunc()
+	}
+	if m.CheckBalanceWithContextFunc != nil {
+		return m.CheckBalanceWithContextFunc(context.Background())
+	}
+	var r0 []paystack.Balance
+	return r0, notStubbed("Client.CheckBalance")
+}
+
+// CheckBalanceWithContext records the call and runs CheckBalanceWithContextFunc
+func (m *Client) CheckBalanceWithContext(ctx context.Context) ([]paystack.Balance, error) {
+	m.record("CheckBalanceWithContext")
+	if m.CheckBalanceWithContextFunc != nil {
+		return m.CheckBalanceWithContextFunc(ctx)
+	}
+	var r0 []paystack.Balance
+	return r0, notStubbed("Client.CheckBalanceWithContext")
+}
+
+// GetSessionTimeout records the call and runs GetSessionTimeoutFunc,
+// or GetSessionTimeoutWithContextFunc with a background context
+func (m *Client) GetSessionTimeout() (*paystack.SessionTimeout, error) {
+	m.record("GetSessionTimeout")
+	if m.GetSessionTimeoutFunc != nil {
+		return m.GetSessionTimeoutFunc()
+	}
+	if m.GetSessionTimeoutWithContextFunc != nil {
+		return m.GetSessionTimeoutWithContextFunc(context.Background())
+	}
+	var r0 *paystack.SessionTimeout
+	return r0, notStubbed("Client.GetSessionTimeout")
+}
+
+// GetSessionTimeoutWithContext records the call and runs GetSessionTimeoutWithContextFunc
+func (m *Client) GetSessionTimeoutWithContext(ctx context.Context) (*paystack.SessionTimeout, error) {
+	m.record("GetSessionTimeoutWithContext")
+	if m.GetSessionTimeoutWithContextFunc != nil {
+		return m.GetSessionTimeoutWithContextFunc(ctx)
+	}
+	var r0 *paystack.SessionTimeout
+	return r0, notStubbed("Client.GetSessionTimeoutWithContext")
+}
+
+// UpdateSessionTimeout records the call and runs UpdateSessionTimeoutFunc,
+// or UpdateSessionTimeoutWithContextFunc with a background context
+func (m *Client) UpdateSessionTimeout(timeout int) (*paystack.SessionTimeout, error) {
+	m.record("UpdateSessionTimeout", timeout)
+	if m.UpdateSessionTimeoutFunc != nil {
+		return m.UpdateSessionTimeoutFunc(timeout)
+	}
+	if m.UpdateSessionTimeoutWithContextFunc != nil {
+		return m.UpdateSessionTimeoutWithContextFunc(context.Background(), timeout)
+	}
+	var r0 *paystack.SessionTimeout
+	return r0, notStubbed("Client.UpdateSessionTimeout")
+}
+
+// UpdateSessionTimeoutWithContext records the call and runs UpdateSessionTimeoutWithContextFunc
+func (m *Client) UpdateSessionTimeoutWithContext(ctx context.Context, timeout int) (*paystack.SessionTimeout, error) {
+	m.record("UpdateSessionTimeoutWithContext", timeout)
+	if m.UpdateSessionTimeoutWithContextFunc != nil {
+		return m.UpdateSessionTimeoutWithContextFunc(ctx, timeout)
+	}
+	var r0 *paystack.SessionTimeout
+	return r0, notStubbed("Client.UpdateSessionTimeoutWithContext")
+}
+
+// NewClient returns a mock client with a mock for every service
+func NewClient() *Client {
+	return &Client{
+		Customer:         &CustomerAPI{},
+		Transaction:      &TransactionAPI{},
+		SubAccount:       &SubAccountAPI{},
+		Plan:             &PlanAPI{},
+		Subscription:     &SubscriptionAPI{},
+		Page:             &PageAPI{},
+		Settlement:       &SettlementAPI{},
+		Transfer:         &TransferAPI{},
+		Charge:           &ChargeAPI{},
+		Bank:             &BankAPI{},
+		BulkCharge:       &BulkChargeAPI{},
+		Refund:           &RefundAPI{},
+		Dispute:          &DisputeAPI{},
+		DedicatedAccount: &DedicatedAccountAPI{},
+		PaymentRequest:   &PaymentRequestAPI{},
+		Product:          &ProductAPI{},
+	}
+}
+
+var (
+	_ paystack.CustomerAPI         = (*CustomerAPI)(nil)
+	_ paystack.TransactionAPI      = (*TransactionAPI)(nil)
+	_ paystack.SubAccountAPI       = (*SubAccountAPI)(nil)
+	_ paystack.PlanAPI             = (*PlanAPI)(nil)
+	_ paystack.SubscriptionAPI     = (*SubscriptionAPI)(nil)
+	_ paystack.PageAPI             = (*PageAPI)(nil)
+	_ paystack.SettlementAPI       = (*SettlementAPI)(nil)
+	_ paystack.TransferAPI         = (*TransferAPI)(nil)
+	_ paystack.ChargeAPI           = (*ChargeAPI)(nil)
+	_ paystack.BankAPI             = (*BankAPI)(nil)
+	_ paystack.BulkChargeAPI       = (*BulkChargeAPI)(nil)
+	_ paystack.RefundAPI           = (*RefundAPI)(nil)
+	_ paystack.DisputeAPI          = (*DisputeAPI)(nil)
+	_ paystack.DedicatedAccountAPI = (*DedicatedAccountAPI)(nil)
+	_ paystack.PaymentRequestAPI   = (*PaymentRequestAPI)(nil)
+	_ paystack.ProductAPI          = (*ProductAPI)(nil)
+	_ paystack.API                 = (*Client)(nil)
+)
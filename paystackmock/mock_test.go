@@ -0,0 +1,89 @@
+package paystackmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	paystack "github.com/rpip/paystack-go"
+)
+
+// verify is code under test that only knows the paystack.API interface
+func verify(api paystack.API, reference string) (string, error) {
+	txn, err := api.Transactions().Verify(reference)
+	if err != nil {
+		return "", err
+	}
+	return txn.Status, nil
+}
+
+func TestStubAndRecord(t *testing.T) {
+	client := NewClient()
+	client.Transaction.VerifyFunc = func(reference string) (*paystack.Transaction, error) {
+		return &paystack.Transaction{Reference: reference, Status: "success"}, nil
+	}
+
+	status, err := verify(client, "ref-1")
+	if err != nil || status != "success" {
+		t.Fatalf("Expected success, got %q, %v", status, err)
+	}
+	calls := client.Transaction.CallsTo("Verify")
+	if len(calls) != 1 || calls[0].Args[0] != "ref-1" {
+		t.Errorf("Expected one call with the reference, got %+v", calls)
+	}
+
+	client.Transaction.Reset()
+	if len(client.Transaction.Calls()) != 0 {
+		t.Error("Expected Reset to forget the calls")
+	}
+}
+
+func TestFallBackToContextStub(t *testing.T) {
+	client := NewClient()
+	client.Customer.GetWithContextFunc = func(ctx context.Context, code string) (*paystack.Customer, error) {
+		if ctx == nil {
+			t.Error("Expected a context")
+		}
+		return &paystack.Customer{CustomerCode: code}, nil
+	}
+	cust, err := client.Customers().Get("CUS_1")
+	if err != nil || cust.CustomerCode != "CUS_1" {
+		t.Fatalf("Expected the context stub to answer, got %+v, %v", cust, err)
+	}
+	if got := client.Customer.CallsTo("Get"); len(got) != 1 {
+		t.Errorf("Expected the plain call to be recorded, got %+v", client.Customer.Calls())
+	}
+}
+
+func TestNotStubbed(t *testing.T) {
+	client := NewClient()
+	_, err := client.Banks().ResolveBVN(12345678901)
+	if !errors.Is(err, ErrNotStubbed) {
+		t.Errorf("Expected ErrNotStubbed, got %v", err)
+	}
+	if _, err := client.CheckBalance(); !errors.Is(err, ErrNotStubbed) {
+		t.Errorf("Expected ErrNotStubbed, got %v", err)
+	}
+
+	it := client.Transfers().ListAll(context.Background(), nil)
+	if it.Next() || !errors.Is(it.Err(), ErrNotStubbed) {
+		t.Errorf("Expected an empty iterator failing with ErrNotStubbed, got %v", it.Err())
+	}
+}
+
+func TestStubIterator(t *testing.T) {
+	client := NewClient()
+	client.Customer.ListAllFunc = func(ctx context.Context, opts *paystack.ListOptions) *paystack.Iterator[paystack.Customer] {
+		return paystack.SliceIterator([]paystack.Customer{{Email: "a@example.com"}, {Email: "b@example.com"}})
+	}
+	var emails []string
+	for c, err := range client.Customers().ListAll(context.Background(), nil).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		emails = append(emails, c.Email)
+	}
+	if len(emails) != 2 || emails[1] != "b@example.com" {
+		t.Errorf("Unexpected customers %v", emails)
+	}
+}
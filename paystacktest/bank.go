@@ -0,0 +1,104 @@
+package paystacktest
+
+import (
+	"net/http"
+	"regexp"
+)
+
+func defaultBanks() []object {
+	banks := []struct{ name, slug, code, longCode string }{
+		{"Access Bank", "access-bank", "044", "044150149"},
+		{"Access Bank (Diamond)", "access-bank-diamond", "063", "063150162"},
+		{"First Bank of Nigeria", "first-bank-of-nigeria", "011", "011151003"},
+		{"Guaranty Trust Bank", "guaranty-trust-bank", "058", "058152036"},
+		{"United Bank For Africa", "united-bank-for-africa", "033", "033153513"},
+		{"Zenith Bank", "zenith-bank", "057", "057150013"},
+	}
+	out := make([]object, len(banks))
+	for i, b := range banks {
+		out[i] = object{
+			"id":         i + 1,
+			"name":       b.name,
+			"slug":       b.slug,
+			"code":       b.code,
+			"longcode":   b.longCode,
+			"gateway":    "emandate",
+			"active":     true,
+			"is_deleted": false,
+			"country":    "Nigeria",
+			"currency":   "NGN",
+			"type":       "nuban",
+		}
+	}
+	return out
+}
+
+var (
+	digits10 = regexp.MustCompile(`^\d{10}$`)
+	digits11 = regexp.MustCompile(`^\d{11}$`)
+)
+
+func (s *Server) listBanks(w http.ResponseWriter, r *request) {
+	writeData(w, http.StatusOK, "Banks retrieved", s.banks)
+}
+
+func (s *Server) resolveAccount(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	number, bankCode := q.Get("account_number"), q.Get("bank_code")
+	if !digits10.MatchString(number) {
+		writeError(w, http.StatusUnprocessableEntity, "Account number is invalid")
+		return
+	}
+	name, ok := s.accounts[bankCode+"/"+number]
+	if !ok {
+		writeError(w, http.StatusUnprocessableEntity, "Could not resolve account name. Check parameters or try again.")
+		return
+	}
+	bank := find(s.banks, bankCode, "code")
+	writeData(w, http.StatusOK, "Account number resolved", object{
+		"account_number": number,
+		"account_name":   name,
+		"bank_id":        bank["id"],
+	})
+}
+
+func (s *Server) resolveBVN(w http.ResponseWriter, r *request, bvn string) {
+	if !digits11.MatchString(bvn) {
+		writeError(w, http.StatusBadRequest, "Invalid BVN")
+		return
+	}
+	writeJSON(w, http.StatusOK, object{
+		"status":  true,
+		"message": "BVN resolved",
+		"data": object{
+			"first_name":    "JANE",
+			"last_name":     "DOE",
+			"dob":           "01-Jan-90",
+			"formatted_dob": "1990-01-01",
+			"mobile":        "08000000000",
+			"bvn":           bvn,
+		},
+		"meta": object{"calls_this_month": 1, "free_calls_left": 0},
+	})
+}
+
+// cardBINs are the test card prefixes the fake knows about
+var cardBINs = map[string]object{
+	"408408": {"brand": "Visa", "sub_brand": "", "card_type": "DEBIT", "bank": "TEST BANK"},
+	"507850": {"brand": "Verve", "sub_brand": "", "card_type": "DEBIT", "bank": "TEST BANK"},
+	"539983": {"brand": "Mastercard", "sub_brand": "", "card_type": "DEBIT", "bank": "Guaranty Trust Bank"},
+}
+
+func (s *Server) resolveBIN(w http.ResponseWriter, r *request, bin string) {
+	if len(bin) > 6 {
+		bin = bin[:6]
+	}
+	data := object{"bin": bin, "brand": "Unknown", "sub_brand": "", "card_type": "", "bank": ""}
+	if info, ok := cardBINs[bin]; ok {
+		merge(data, info, "brand", "sub_brand", "card_type", "bank")
+	}
+	data["country_code"] = "NG"
+	data["country_name"] = "Nigeria"
+	data["linked_bank_id"] = 0
+	writeData(w, http.StatusOK, "Bin resolved", data)
+}
@@ -0,0 +1,118 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// batchStatus reports the bulk charge counts of batch
+func (s *Server) batchStatus(batch object) (total, pending int) {
+	for _, c := range s.bulkCharges {
+		if c["batch"] == batch["id"] {
+			total++
+			if c["status"] == "pending" {
+				pending++
+			}
+		}
+	}
+	return total, pending
+}
+
+func (s *Server) initiateBulkCharge(w http.ResponseWriter, r *request) {
+	items, _ := r.body["items"].([]interface{})
+	if len(items) == 0 {
+		writeError(w, http.StatusBadRequest, "Please provide an array of objects with authorization codes and amounts")
+		return
+	}
+	batch := s.newObject()
+	batch["batch_code"] = code("BCH", batch["id"])
+	batch["reference"] = fmt.Sprintf("bulkcharge-%d", batch["id"])
+	batch["status"] = "active"
+	for _, item := range items {
+		body, _ := item.(map[string]interface{})
+		if body == nil || str(body, "authorization") == "" {
+			writeInvalid(w, "authorization", "Authorization is required")
+			return
+		}
+		amt, ok := integer(body, "amount")
+		if !ok || amt <= 0 {
+			writeInvalid(w, "amount", "Invalid amount passed")
+			return
+		}
+		charge := s.newObject()
+		charge["batch"] = batch["id"]
+		charge["reference"] = str(body, "reference")
+		charge["amount"] = amt
+		charge["currency"] = "NGN"
+		charge["status"] = "pending"
+		if cust, auth := s.findAuthorization(str(body, "authorization")); auth != nil {
+			charge["customer"] = copyObject(cust)
+			charge["authorization"] = auth
+		} else {
+			charge["status"] = "failed"
+			charge["message"] = "Invalid authorization code"
+		}
+		s.bulkCharges = append(s.bulkCharges, charge)
+	}
+	s.batches = append(s.batches, batch)
+	writeData(w, http.StatusOK, "Charges have been queued", s.batchView(batch))
+}
+
+// batchView returns batch with its current charge counts
+func (s *Server) batchView(batch object) object {
+	out := copyObject(batch)
+	out["total_charges"], out["pending_charges"] = s.batchStatus(batch)
+	return out
+}
+
+func (s *Server) listBatches(w http.ResponseWriter, r *request) {
+	batches := newest(s.batches)
+	for i, b := range batches {
+		batches[i] = s.batchView(b)
+	}
+	writeList(w, r, "Bulk charges retrieved", batches)
+}
+
+func (s *Server) getBatch(w http.ResponseWriter, r *request, idOrCode string) {
+	batch := find(s.batches, idOrCode, "batch_code")
+	if batch == nil {
+		writeError(w, http.StatusNotFound, "Bulk charge batch not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Bulk charge retrieved", s.batchView(batch))
+}
+
+func (s *Server) batchCharges(w http.ResponseWriter, r *request, idOrCode string) {
+	batch := find(s.batches, idOrCode, "batch_code")
+	if batch == nil {
+		writeError(w, http.StatusNotFound, "Bulk charge batch not found")
+		return
+	}
+	q := r.URL.Query()
+	charges := filter(s.bulkCharges, func(o object) bool {
+		if o["batch"] != batch["id"] {
+			return false
+		}
+		return q.Get("status") == "" || o["status"] == q.Get("status")
+	})
+	writeList(w, r, "Bulk charge items retrieved", charges)
+}
+
+func (s *Server) pauseBatch(w http.ResponseWriter, r *request, batchCode string) {
+	s.setBatchStatus(w, batchCode, "paused", "Bulk charge batch has been paused")
+}
+
+func (s *Server) resumeBatch(w http.ResponseWriter, r *request, batchCode string) {
+	s.setBatchStatus(w, batchCode, "active", "Bulk charge batch has been resumed")
+}
+
+func (s *Server) setBatchStatus(w http.ResponseWriter, batchCode, status, message string) {
+	batch := find(s.batches, batchCode, "batch_code")
+	if batch == nil {
+		writeError(w, http.StatusNotFound, "Bulk charge batch not found")
+		return
+	}
+	batch["status"] = status
+	s.touch(batch)
+	writeMessage(w, message)
+}
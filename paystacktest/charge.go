@@ -0,0 +1,183 @@
+package paystacktest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// cardAuthorization returns a new authorization for the card in body
+func (s *Server) cardAuthorization(card object) object {
+	number := strings.ReplaceAll(str(card, "card_number"), " ", "")
+	bin, last4 := number, number
+	if len(number) >= 10 {
+		bin, last4 = number[:6], number[len(number)-4:]
+	}
+	brand := "unknown"
+	if info, ok := cardBINs[bin]; ok {
+		brand = strings.ToLower(info["brand"].(string))
+	}
+	auth := s.newAuthorization(bin, last4, brand)
+	if v := str(card, "expiry_month"); v != "" {
+		auth["exp_month"] = v
+	}
+	if v := str(card, "expiry_year"); v != "" {
+		auth["exp_year"] = v
+	}
+	return auth
+}
+
+func (s *Server) createCharge(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "email") {
+		return
+	}
+	amt, ok := amount(w, r.body)
+	if !ok {
+		return
+	}
+	ref := str(r.body, "reference")
+	if s.duplicateReference(ref) {
+		writeError(w, http.StatusBadRequest, "Duplicate Transaction Reference")
+		return
+	}
+
+	card, _ := r.body["card"].(map[string]interface{})
+	bank, _ := r.body["bank"].(map[string]interface{})
+	authCode := str(r.body, "authorization_code")
+	var auth object
+	switch {
+	case authCode != "":
+		cust, a := s.findAuthorization(authCode)
+		if a == nil || cust["email"] != str(r.body, "email") {
+			writeError(w, http.StatusBadRequest, "Invalid authorization code")
+			return
+		}
+		auth = a
+	case card != nil:
+		if !require(w, card, "card_number", "card_cvc", "expiry_month", "expiry_year") {
+			return
+		}
+		auth = s.cardAuthorization(card)
+	case bank != nil:
+		if !require(w, bank, "code", "account_number") {
+			return
+		}
+		if find(s.banks, str(bank, "code"), "code") == nil {
+			writeInvalid(w, "bank", "Bank code is invalid")
+			return
+		}
+		number := str(bank, "account_number")
+		auth = s.newAuthorization("", number[max(0, len(number)-4):], "")
+		auth["channel"] = "bank"
+		auth["reusable"] = false
+	default:
+		writeError(w, http.StatusBadRequest, "Please provide a card, bank or authorization code")
+		return
+	}
+
+	// Details sent with the charge answer the steps that would ask for them.
+	// They are checked before anything is stored.
+	f := &flow{Scenario: s.scenarioFor(card, bank)}
+	pin, birthday := str(r.body, "pin"), str(r.body, "birthday")
+	for (f.step() == StepPIN && pin != "") || (f.step() == StepBirthday && birthday != "") {
+		if f.step() == StepPIN && f.PIN != "" && pin != f.PIN {
+			writeError(w, http.StatusBadRequest, "Incorrect PIN")
+			return
+		}
+		if !f.next() {
+			break
+		}
+	}
+
+	txn := s.newTransaction(str(r.body, "email"), amt, str(r.body, "currency"), ref)
+	txn["authorization"] = auth
+	s.flows[txn["reference"].(string)] = f
+	if !s.enter(txn, f) {
+		r.hold = true
+		return
+	}
+	writeData(w, http.StatusOK, "Charge attempted", txn)
+}
+
+func (s *Server) tokenize(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "email") {
+		return
+	}
+	card, _ := r.body["card"].(map[string]interface{})
+	if card == nil {
+		writeInvalid(w, "card", "Card is required")
+		return
+	}
+	if !require(w, card, "card_number", "card_cvc", "expiry_month", "expiry_year") {
+		return
+	}
+	cust := s.customerFor(str(r.body, "email"))
+	auth := s.cardAuthorization(card)
+	s.saveAuthorization(cust, auth)
+	out := copyObject(auth)
+	out["customer"] = copyObject(cust)
+	writeData(w, http.StatusOK, "Charge tokenized", out)
+}
+
+// submitCharge continues a charge that is waiting for the given input, one
+// of "pin", "otp", "phone" or "birthday"
+func (s *Server) submitCharge(w http.ResponseWriter, r *request, input string) {
+	if !require(w, r.body, input, "reference") {
+		return
+	}
+	ref := str(r.body, "reference")
+	txn := find(s.transactions, ref, "reference")
+	f := s.flows[ref]
+	if txn == nil || f == nil {
+		writeError(w, http.StatusNotFound, "Charge not found")
+		return
+	}
+	if f.step() != Step("send_"+input) {
+		writeError(w, http.StatusBadRequest, "Charge is not awaiting "+inputNames[input])
+		return
+	}
+	v := str(r.body, input)
+	switch {
+	case input == "pin" && f.PIN != "" && v != f.PIN:
+		writeError(w, http.StatusBadRequest, "Incorrect PIN")
+		return
+	case input == "otp" && v != OTP:
+		writeError(w, http.StatusBadRequest, "Invalid OTP")
+		return
+	case input == "birthday" && !isDate(v):
+		writeInvalid(w, "birthday", "Birthday must be in the format YYYY-MM-DD")
+		return
+	}
+	f.next()
+	if !s.enter(txn, f) {
+		r.hold = true
+		return
+	}
+	writeData(w, http.StatusOK, "Charge attempted", txn)
+}
+
+var inputNames = map[string]string{"pin": "PIN", "otp": "OTP", "phone": "phone", "birthday": "birthday"}
+
+func isDate(v string) bool {
+	_, err := time.Parse("2006-01-02", v)
+	return err == nil
+}
+
+// checkPending reports the status of a charge. Polling a pending charge
+// moves it to its next step.
+func (s *Server) checkPending(w http.ResponseWriter, r *request, ref string) {
+	txn := find(s.transactions, ref, "reference")
+	if txn == nil {
+		writeError(w, http.StatusNotFound, "Charge not found")
+		return
+	}
+	if f := s.flows[ref]; f != nil {
+		if step := f.step(); (step == StepPending || step == StepTimeout) && f.next() {
+			if !s.enter(txn, f) {
+				r.hold = true
+				return
+			}
+		}
+	}
+	writeData(w, http.StatusOK, "Reference check successful", txn)
+}
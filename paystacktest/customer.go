@@ -0,0 +1,101 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// customerFor returns the customer with email, creating it if needed
+func (s *Server) customerFor(email string) object {
+	if cust := find(s.customers, email, "email"); cust != nil {
+		return cust
+	}
+	cust := s.newObject()
+	cust["customer_code"] = code("CUS", cust["id"])
+	cust["email"] = email
+	cust["risk_action"] = "default"
+	cust["metadata"] = nil
+	cust["authorizations"] = []object{}
+	cust["subscriptions"] = []object{}
+	s.customers = append(s.customers, cust)
+	return cust
+}
+
+func (s *Server) createCustomer(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "email") {
+		return
+	}
+	email := str(r.body, "email")
+	if !strings.Contains(email, "@") {
+		writeInvalid(w, "email", "Invalid Email Address Passed")
+		return
+	}
+	cust := s.customerFor(email)
+	merge(cust, r.body, "first_name", "last_name", "phone", "metadata")
+	writeData(w, http.StatusOK, "Customer created", cust)
+}
+
+func (s *Server) getCustomer(w http.ResponseWriter, r *request, idOrCode string) {
+	cust := find(s.customers, idOrCode, "customer_code", "email")
+	if cust == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Customer retrieved", cust)
+}
+
+func (s *Server) updateCustomer(w http.ResponseWriter, r *request, idOrCode string) {
+	cust := find(s.customers, idOrCode, "customer_code")
+	if cust == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	merge(cust, r.body, "first_name", "last_name", "phone", "metadata")
+	s.touch(cust)
+	writeData(w, http.StatusOK, "Customer updated", cust)
+}
+
+func (s *Server) listCustomers(w http.ResponseWriter, r *request) {
+	custs := filter(newest(s.customers), func(o object) bool { return inRange(r, o) })
+	writeList(w, r, "Customers retrieved", custs)
+}
+
+func (s *Server) setRiskAction(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "customer") {
+		return
+	}
+	cust := find(s.customers, str(r.body, "customer"), "customer_code", "email")
+	if cust == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	action := str(r.body, "risk_action")
+	switch action {
+	case "":
+		action = "default"
+	case "allow", "deny", "default":
+	default:
+		writeInvalid(w, "risk_action", fmt.Sprintf("Risk action %q is invalid", action))
+		return
+	}
+	cust["risk_action"] = action
+	s.touch(cust)
+	writeData(w, http.StatusOK, "Customer updated", cust)
+}
+
+func (s *Server) deactivateAuthorization(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "authorization_code") {
+		return
+	}
+	authCode := str(r.body, "authorization_code")
+	cust, auth := s.findAuthorization(authCode)
+	if auth == nil {
+		writeError(w, http.StatusNotFound, "Authorization code not found")
+		return
+	}
+	cust["authorizations"] = filter(cust["authorizations"].([]object), func(o object) bool {
+		return o["authorization_code"] != authCode
+	})
+	writeMessage(w, "Authorization has been deactivated")
+}
@@ -0,0 +1,224 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// providers are the banks dedicated accounts can be opened with
+var providers = []object{
+	{"id": 1, "provider_slug": "wema-bank", "bank_id": 20, "bank_name": "Wema Bank"},
+	{"id": 2, "provider_slug": "titan-paystack", "bank_id": 68, "bank_name": "Paystack-Titan"},
+	{"id": 3, "provider_slug": "test-bank", "bank_id": 200, "bank_name": "Test Bank"},
+}
+
+func provider(slug string) object {
+	for _, p := range providers {
+		if p["provider_slug"] == slug {
+			return p
+		}
+	}
+	return nil
+}
+
+// newDedicatedAccount opens an account with the provider for cust, taking
+// the name from the customer or the request body
+func (s *Server) newDedicatedAccount(w http.ResponseWriter, cust object, body object) object {
+	merge(cust, body, "first_name", "last_name", "phone")
+	if str(cust, "first_name") == "" || str(cust, "last_name") == "" {
+		writeInvalid(w, "first_name", "Customer first name and last name are required")
+		return nil
+	}
+	slug := str(body, "preferred_bank")
+	if slug == "" {
+		slug = "test-bank"
+	}
+	bank := provider(slug)
+	if bank == nil {
+		writeInvalid(w, "preferred_bank", "Preferred bank is not a supported provider")
+		return nil
+	}
+	for _, a := range s.dedicatedAccounts {
+		if a["active"] == true && a["customer"].(object)["id"] == cust["id"] && a["bank"].(object)["slug"] == slug {
+			writeError(w, http.StatusBadRequest, "Customer already has an active dedicated account with this bank")
+			return nil
+		}
+	}
+	split, ok := s.splitConfig(w, body)
+	if !ok {
+		return nil
+	}
+
+	account := snakeCase(s.newObject())
+	delete(account, "domain")
+	account["account_number"] = fmt.Sprintf("99%08d", account["id"])
+	account["account_name"] = strings.ToUpper(fmt.Sprintf("PAYSTACKTEST/%s %s", cust["first_name"], cust["last_name"]))
+	account["assigned"] = true
+	account["active"] = true
+	account["currency"] = "NGN"
+	account["metadata"] = nil
+	account["bank"] = object{"id": bank["bank_id"], "name": bank["bank_name"], "slug": slug}
+	account["customer"] = copyObject(cust)
+	account["assignment"] = object{
+		"integration":   account["integration"],
+		"assignee_id":   cust["id"],
+		"assignee_type": "Customer",
+		"expired":       false,
+		"account_type":  "PAY-WITH-TRANSFER-RECURRING",
+		"assigned_at":   account["created_at"],
+	}
+	account["split_config"] = split
+	s.dedicatedAccounts = append(s.dedicatedAccounts, account)
+	return account
+}
+
+// splitConfig reads the subaccount or split code payments into an account
+// are shared with
+func (s *Server) splitConfig(w http.ResponseWriter, body object) (object, bool) {
+	if code := str(body, "subaccount"); code != "" {
+		if find(s.subaccounts, code, "subaccount_code") == nil {
+			writeInvalid(w, "subaccount", "Subaccount not found")
+			return nil, false
+		}
+		return object{"subaccount": code}, true
+	}
+	if code := str(body, "split_code"); code != "" {
+		return object{"split_code": code}, true
+	}
+	return nil, true
+}
+
+func (s *Server) createDedicatedAccount(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "customer") {
+		return
+	}
+	cust := find(s.customers, str(r.body, "customer"), "customer_code")
+	if cust == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	if account := s.newDedicatedAccount(w, cust, r.body); account != nil {
+		writeData(w, http.StatusOK, "NUBAN successfully created", account)
+	}
+}
+
+// assignDedicatedAccount creates the customer and the account at once.
+// Paystack does this in the background and reports the outcome by webhook.
+func (s *Server) assignDedicatedAccount(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "email", "first_name", "last_name", "phone", "preferred_bank", "country") {
+		return
+	}
+	cust := s.customerFor(str(r.body, "email"))
+	if s.newDedicatedAccount(w, cust, r.body) != nil {
+		writeMessage(w, "Assign dedicated account in progress")
+	}
+}
+
+func (s *Server) listDedicatedAccounts(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	accounts := filter(newest(s.dedicatedAccounts), func(o object) bool {
+		if v := q.Get("active"); v != "" && fmt.Sprint(o["active"]) != v {
+			return false
+		}
+		if v := q.Get("currency"); v != "" && o["currency"] != v {
+			return false
+		}
+		bank := o["bank"].(object)
+		if v := q.Get("provider_slug"); v != "" && bank["slug"] != v {
+			return false
+		}
+		if v := q.Get("bank_id"); v != "" && fmt.Sprint(bank["id"]) != v {
+			return false
+		}
+		if v := q.Get("customer"); v != "" && fmt.Sprint(o["customer"].(object)["id"]) != v {
+			return false
+		}
+		return true
+	})
+	writeList(w, r, "Managed accounts successfully retrieved", accounts)
+}
+
+func (s *Server) getDedicatedAccount(w http.ResponseWriter, r *request, id string) {
+	account := find(s.dedicatedAccounts, id)
+	if account == nil {
+		writeError(w, http.StatusNotFound, "Dedicated account not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Dedicated account retrieved", account)
+}
+
+func (s *Server) requeryDedicatedAccount(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	if q.Get("account_number") == "" {
+		writeInvalid(w, "account_number", "Account number is required")
+		return
+	}
+	account := find(s.dedicatedAccounts, q.Get("account_number"), "account_number")
+	if account == nil || account["bank"].(object)["slug"] != q.Get("provider_slug") {
+		writeError(w, http.StatusNotFound, "Dedicated account not found")
+		return
+	}
+	writeMessage(w, "We are checking the status of your transfer. We will send you a notification once it is confirmed")
+}
+
+func (s *Server) deactivateDedicatedAccount(w http.ResponseWriter, r *request, id string) {
+	account := find(s.dedicatedAccounts, id)
+	if account == nil {
+		writeError(w, http.StatusNotFound, "Dedicated account not found")
+		return
+	}
+	account["active"] = false
+	account["assigned"] = false
+	account["assignment"].(object)["expired"] = true
+	s.touchSnake(account)
+	writeData(w, http.StatusOK, "Managed Account Successfully Unassigned", account)
+}
+
+func (s *Server) addDedicatedAccountSplit(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "customer") {
+		return
+	}
+	cust := find(s.customers, str(r.body, "customer"), "customer_code")
+	if cust == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	split, ok := s.splitConfig(w, r.body)
+	if !ok {
+		return
+	}
+	if split == nil {
+		writeInvalid(w, "split_code", "Subaccount or split code is required")
+		return
+	}
+	for _, a := range newest(s.dedicatedAccounts) {
+		if a["active"] == true && a["customer"].(object)["id"] == cust["id"] {
+			a["split_config"] = split
+			s.touchSnake(a)
+			writeData(w, http.StatusOK, "Assigned Managed Account Successfully Created", a)
+			return
+		}
+	}
+	if account := s.newDedicatedAccount(w, cust, r.body); account != nil {
+		writeData(w, http.StatusOK, "Assigned Managed Account Successfully Created", account)
+	}
+}
+
+func (s *Server) removeDedicatedAccountSplit(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "account_number") {
+		return
+	}
+	account := find(s.dedicatedAccounts, str(r.body, "account_number"), "account_number")
+	if account == nil {
+		writeError(w, http.StatusNotFound, "Dedicated account not found")
+		return
+	}
+	account["split_config"] = nil
+	s.touchSnake(account)
+	writeData(w, http.StatusOK, "Subaccount unassigned", account)
+}
+
+func (s *Server) availableProviders(w http.ResponseWriter, r *request) {
+	writeData(w, http.StatusOK, "Available providers retrieved", providers)
+}
@@ -0,0 +1,203 @@
+package paystacktest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// OpenDispute raises a chargeback against the successful transaction with
+// the given reference, as a customer's bank would, and returns its ID
+func (s *Server) OpenDispute(reference string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	txn := find(s.transactions, reference, "reference")
+	if txn == nil {
+		return 0, fmt.Errorf("paystacktest: no transaction with reference %q", reference)
+	}
+	if txn["status"] != "success" {
+		return 0, fmt.Errorf("paystacktest: transaction %q was not successful", reference)
+	}
+
+	dispute := s.newObject()
+	dispute["refund_amount"] = txn["amount"]
+	dispute["currency"] = txn["currency"]
+	dispute["status"] = "awaiting-merchant-feedback"
+	dispute["resolution"] = nil
+	dispute["category"] = "chargeback"
+	dispute["transaction"] = copyObject(txn)
+	dispute["transaction_reference"] = txn["reference"]
+	dispute["customer"] = copyObject(txn["customer"].(object))
+	if auth, ok := txn["authorization"].(object); ok {
+		dispute["bin"] = auth["bin"]
+		dispute["last4"] = auth["last4"]
+	}
+	dispute["dueAt"] = s.now().Add(7 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	dispute["evidence"] = nil
+	dispute["history"] = []object{s.disputeHistory("awaiting-merchant-feedback", "bank")}
+	dispute["messages"] = []object{}
+	s.disputes = append(s.disputes, dispute)
+	return dispute["id"].(int), nil
+}
+
+func (s *Server) disputeHistory(status, by string) object {
+	return object{"status": status, "by": by, "createdAt": s.now().UTC().Format(time.RFC3339)}
+}
+
+// disputeFilter keeps the disputes matching the list and export query
+func disputeFilter(r *request) func(object) bool {
+	q := r.URL.Query()
+	return func(o object) bool {
+		if v := q.Get("status"); v != "" && o["status"] != v {
+			return false
+		}
+		if v := q.Get("transaction"); v != "" && fmt.Sprint(o["transaction"].(object)["id"]) != v {
+			return false
+		}
+		return inRange(r, o)
+	}
+}
+
+func (s *Server) listDisputes(w http.ResponseWriter, r *request) {
+	writeList(w, r, "Disputes retrieved", filter(newest(s.disputes), disputeFilter(r)))
+}
+
+func (s *Server) getDispute(w http.ResponseWriter, r *request, id string) {
+	dispute := find(s.disputes, id)
+	if dispute == nil {
+		writeError(w, http.StatusNotFound, "Dispute not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Dispute retrieved", dispute)
+}
+
+func (s *Server) transactionDisputes(w http.ResponseWriter, r *request, id string) {
+	for _, d := range newest(s.disputes) {
+		if fmt.Sprint(d["transaction"].(object)["id"]) == id {
+			writeData(w, http.StatusOK, "Dispute retrieved", d)
+			return
+		}
+	}
+	writeData(w, http.StatusOK, "Dispute retrieved", nil)
+}
+
+func (s *Server) updateDispute(w http.ResponseWriter, r *request, id string) {
+	dispute := find(s.disputes, id)
+	if dispute == nil {
+		writeError(w, http.StatusNotFound, "Dispute not found")
+		return
+	}
+	if n, ok := integer(r.body, "refund_amount"); ok {
+		if n > dispute["transaction"].(object)["amount"].(int64) {
+			writeInvalid(w, "refund_amount", "Refund amount cannot be more than the transaction amount")
+			return
+		}
+		dispute["refund_amount"] = n
+	}
+	merge(dispute, r.body, "uploaded_filename")
+	s.touch(dispute)
+	writeData(w, http.StatusOK, "Dispute updated successfully", dispute)
+}
+
+func (s *Server) addEvidence(w http.ResponseWriter, r *request, id string) {
+	dispute := find(s.disputes, id)
+	if dispute == nil {
+		writeError(w, http.StatusNotFound, "Dispute not found")
+		return
+	}
+	if !require(w, r.body, "customer_email", "customer_name", "customer_phone", "service_details") {
+		return
+	}
+	evidence := s.newObject()
+	delete(evidence, "integration")
+	delete(evidence, "domain")
+	evidence["dispute"] = dispute["id"]
+	merge(evidence, r.body, "customer_email", "customer_name", "customer_phone",
+		"service_details", "delivery_address", "delivery_date")
+	dispute["evidence"] = evidence
+	s.touch(dispute)
+	writeData(w, http.StatusOK, "Evidence created", evidence)
+}
+
+func (s *Server) disputeUploadURL(w http.ResponseWriter, r *request, id string) {
+	if find(s.disputes, id) == nil {
+		writeError(w, http.StatusNotFound, "Dispute not found")
+		return
+	}
+	name := r.URL.Query().Get("upload_filename")
+	if name == "" {
+		writeInvalid(w, "upload_filename", "Upload filename is required")
+		return
+	}
+	writeData(w, http.StatusOK, "Upload url generated", object{
+		"signedUrl": "http://" + r.Host + "/uploads/" + name,
+		"fileName":  name,
+		"expiresIn": 3600,
+	})
+}
+
+func (s *Server) resolveDispute(w http.ResponseWriter, r *request, id string) {
+	dispute := find(s.disputes, id)
+	if dispute == nil {
+		writeError(w, http.StatusNotFound, "Dispute not found")
+		return
+	}
+	if !require(w, r.body, "resolution", "message", "refund_amount", "uploaded_filename") {
+		return
+	}
+	if dispute["status"] == "resolved" {
+		writeError(w, http.StatusBadRequest, "Dispute has already been resolved")
+		return
+	}
+	resolution := str(r.body, "resolution")
+	if resolution != "merchant-accepted" && resolution != "declined" {
+		writeInvalid(w, "resolution", "Resolution must be merchant-accepted or declined")
+		return
+	}
+	if resolution == "declined" && dispute["evidence"] == nil {
+		writeError(w, http.StatusBadRequest, "Evidence is required to decline a dispute")
+		return
+	}
+
+	refund, _ := integer(r.body, "refund_amount")
+	dispute["refund_amount"] = refund
+	dispute["status"] = "resolved"
+	dispute["resolution"] = resolution
+	dispute["resolvedAt"] = s.now().UTC().Format(time.RFC3339)
+	dispute["messages"] = append(dispute["messages"].([]object), object{
+		"sender": "merchant", "body": str(r.body, "message"), "createdAt": dispute["resolvedAt"],
+	})
+	dispute["history"] = append(dispute["history"].([]object), s.disputeHistory("resolved", "merchant"))
+	merge(dispute, r.body, "uploaded_filename")
+	s.touch(dispute)
+	writeData(w, http.StatusOK, "Dispute successfully resolved", dispute)
+}
+
+func (s *Server) exportDisputes(w http.ResponseWriter, r *request) {
+	path := "/exports/disputes.csv"
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+	writeData(w, http.StatusOK, "Export successful", object{"path": "http://" + r.Host + path})
+}
+
+// serveDisputeExport writes the disputes matching the export query as CSV
+func (s *Server) serveDisputeExport(w http.ResponseWriter, r *request) {
+	w.Header().Set("Content-Type", "text/csv")
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"id", "transaction_reference", "refund_amount", "currency", "status", "category", "customer_email", "created_at"})
+	for _, d := range filter(s.disputes, disputeFilter(r)) {
+		cw.Write([]string{
+			fmt.Sprint(d["id"]),
+			fmt.Sprint(d["transaction_reference"]),
+			fmt.Sprint(d["refund_amount"]),
+			fmt.Sprint(d["currency"]),
+			fmt.Sprint(d["status"]),
+			fmt.Sprint(d["category"]),
+			fmt.Sprint(d["customer"].(object)["email"]),
+			fmt.Sprint(d["createdAt"]),
+		})
+	}
+	cw.Flush()
+}
@@ -0,0 +1,27 @@
+package paystacktest
+
+import "net/http"
+
+func (s *Server) balance(w http.ResponseWriter, r *request) {
+	out := []object{}
+	for _, cur := range sortedKeys(s.balances) {
+		out = append(out, object{"currency": cur, "balance": s.balances[cur]})
+	}
+	writeData(w, http.StatusOK, "Balances retrieved", out)
+}
+
+func (s *Server) getSessionTimeout(w http.ResponseWriter, r *request) {
+	writeData(w, http.StatusOK, "Payment session timeout retrieved",
+		object{"payment_session_timeout": s.sessionTimeout})
+}
+
+func (s *Server) updateSessionTimeout(w http.ResponseWriter, r *request) {
+	n, ok := integer(r.body, "timeout")
+	if !ok || n < 0 {
+		writeInvalid(w, "timeout", "Timeout is invalid")
+		return
+	}
+	s.sessionTimeout = int(n)
+	writeData(w, http.StatusOK, "Payment session timeout updated",
+		object{"payment_session_timeout": s.sessionTimeout})
+}
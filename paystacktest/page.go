@@ -0,0 +1,126 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var nonSlug = regexp.MustCompile(`[^a-z0-9]+`)
+
+func (s *Server) createPage(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "name") {
+		return
+	}
+	page := s.newObject()
+	page["active"] = true
+	page["currency"] = "NGN"
+	page["slug"] = fmt.Sprintf("%s-%d", strings.Trim(nonSlug.ReplaceAllString(strings.ToLower(str(r.body, "name")), "-"), "-"), page["id"])
+	if _, ok := r.body["amount"]; ok {
+		amt, ok := amount(w, r.body)
+		if !ok {
+			return
+		}
+		page["amount"] = amt
+	}
+	if slug := str(r.body, "slug"); slug != "" {
+		if find(s.pages, slug, "slug") != nil {
+			writeError(w, http.StatusBadRequest, "Slug is already taken")
+			return
+		}
+		page["slug"] = slug
+	}
+	merge(page, r.body, "name", "description", "currency", "redirect_url", "custom_fields", "metadata")
+	s.pages = append(s.pages, page)
+	writeData(w, http.StatusOK, "Page created", page)
+}
+
+func (s *Server) getPage(w http.ResponseWriter, r *request, idOrSlug string) {
+	page := find(s.pages, idOrSlug, "slug")
+	if page == nil {
+		writeError(w, http.StatusNotFound, "Page not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Page retrieved", page)
+}
+
+func (s *Server) updatePage(w http.ResponseWriter, r *request, idOrSlug string) {
+	page := find(s.pages, idOrSlug, "slug")
+	if page == nil {
+		writeError(w, http.StatusNotFound, "Page not found")
+		return
+	}
+	if _, ok := r.body["amount"]; ok {
+		amt, ok := amount(w, r.body)
+		if !ok {
+			return
+		}
+		page["amount"] = amt
+	}
+	merge(page, r.body, "name", "description", "active", "redirect_url", "custom_fields", "metadata")
+	s.touch(page)
+	writeData(w, http.StatusOK, "Page updated", page)
+}
+
+func (s *Server) listPages(w http.ResponseWriter, r *request) {
+	writeList(w, r, "Pages retrieved", newest(s.pages))
+}
+
+func (s *Server) createSubaccount(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "business_name", "settlement_bank", "account_number", "percentage_charge") {
+		return
+	}
+	bank := find(s.banks, str(r.body, "settlement_bank"), "code", "name")
+	if bank == nil {
+		writeInvalid(w, "settlement_bank", "Settlement bank is invalid")
+		return
+	}
+	acct := s.newObject()
+	acct["subaccount_code"] = code("ACCT", acct["id"])
+	acct["settlement_bank"] = bank["name"]
+	acct["settlement_schedule"] = "AUTO"
+	acct["active"] = true
+	acct["migrate"] = false
+	merge(acct, r.body, "business_name", "description", "account_number", "percentage_charge",
+		"primary_contact_email", "primary_contact_name", "primary_contact_phone", "metadata", "settlement_schedule")
+	s.subaccounts = append(s.subaccounts, acct)
+	writeData(w, http.StatusCreated, "Subaccount created", acct)
+}
+
+func (s *Server) getSubaccount(w http.ResponseWriter, r *request, idOrCode string) {
+	acct := find(s.subaccounts, idOrCode, "subaccount_code")
+	if acct == nil {
+		writeError(w, http.StatusNotFound, "Subaccount not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Subaccount retrieved", acct)
+}
+
+func (s *Server) updateSubaccount(w http.ResponseWriter, r *request, idOrCode string) {
+	acct := find(s.subaccounts, idOrCode, "subaccount_code")
+	if acct == nil {
+		writeError(w, http.StatusNotFound, "Subaccount not found")
+		return
+	}
+	if v := str(r.body, "settlement_bank"); v != "" {
+		bank := find(s.banks, v, "code", "name")
+		if bank == nil {
+			writeInvalid(w, "settlement_bank", "Settlement bank is invalid")
+			return
+		}
+		acct["settlement_bank"] = bank["name"]
+	}
+	merge(acct, r.body, "business_name", "description", "account_number", "percentage_charge", "active",
+		"primary_contact_email", "primary_contact_name", "primary_contact_phone", "metadata", "settlement_schedule")
+	s.touch(acct)
+	writeData(w, http.StatusOK, "Subaccount updated", acct)
+}
+
+func (s *Server) listSubaccounts(w http.ResponseWriter, r *request) {
+	writeList(w, r, "Subaccounts retrieved", newest(s.subaccounts))
+}
+
+func (s *Server) listSettlements(w http.ResponseWriter, r *request) {
+	writeList(w, r, "Settlements retrieved", []object{})
+}
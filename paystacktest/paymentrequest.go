@@ -0,0 +1,276 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PayPaymentRequest marks the payment request with the given code as paid,
+// as if the customer had paid the invoice
+func (s *Server) PayPaymentRequest(code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pr := find(s.paymentRequests, code, "request_code")
+	if pr == nil {
+		return fmt.Errorf("paystacktest: no payment request with code %q", code)
+	}
+	if pr["status"] != "pending" {
+		return fmt.Errorf("paystacktest: payment request %q is %v", code, pr["status"])
+	}
+	pr["status"] = "success"
+	pr["paid"] = true
+	pr["paid_at"] = s.now().UTC().Format(time.RFC3339)
+	s.touchSnake(pr)
+	return nil
+}
+
+// charges reads the line items or taxes under key, answering a validation
+// error if any is malformed
+func charges(w http.ResponseWriter, body object, key string) ([]object, bool) {
+	raw, _ := body[key].([]interface{})
+	items := []object{}
+	for _, v := range raw {
+		in, _ := v.(map[string]interface{})
+		amt, ok := integer(in, "amount")
+		if !ok || amt <= 0 || str(in, "name") == "" {
+			writeInvalid(w, key, "Each of "+key+" needs a name and a positive amount")
+			return nil, false
+		}
+		item := object{"name": str(in, "name"), "amount": amt}
+		if key == "line_items" {
+			qty, ok := integer(in, "quantity")
+			if !ok || qty <= 0 {
+				qty = 1
+			}
+			item["quantity"] = qty
+		}
+		items = append(items, item)
+	}
+	return items, true
+}
+
+// setPaymentRequest applies the fields of a create or update request to pr
+func (s *Server) setPaymentRequest(w http.ResponseWriter, pr, body object) bool {
+	if v := str(body, "customer"); v != "" {
+		cust := find(s.customers, v, "customer_code")
+		if cust == nil {
+			writeError(w, http.StatusNotFound, "Customer not found")
+			return false
+		}
+		pr["customer"] = copyObject(cust)
+	}
+	if _, ok := body["line_items"]; ok {
+		items, ok := charges(w, body, "line_items")
+		if !ok {
+			return false
+		}
+		pr["line_items"] = items
+	}
+	if _, ok := body["tax"]; ok {
+		taxes, ok := charges(w, body, "tax")
+		if !ok {
+			return false
+		}
+		pr["tax"] = taxes
+	}
+	if _, ok := body["amount"]; ok {
+		amt, ok := amount(w, body)
+		if !ok {
+			return false
+		}
+		pr["amount"] = amt
+	} else if len(pr["line_items"].([]object)) > 0 {
+		// without an amount the customer is billed the items plus tax
+		var total int64
+		for _, li := range pr["line_items"].([]object) {
+			total += li["amount"].(int64) * li["quantity"].(int64)
+		}
+		for _, t := range pr["tax"].([]object) {
+			total += t["amount"].(int64)
+		}
+		pr["amount"] = total
+	}
+	if pr["amount"] == nil {
+		writeInvalid(w, "amount", "Amount or line items are required")
+		return false
+	}
+	merge(pr, body, "currency", "due_date", "description", "has_invoice", "invoice_number", "split_code")
+	return true
+}
+
+// notify records the payment request being emailed, unless the request
+// body opts out
+func (s *Server) notify(pr, body object) {
+	if v, ok := body["send_notification"].(bool); ok && !v {
+		return
+	}
+	pr["notifications"] = append(pr["notifications"].([]object), object{
+		"sent_at": s.now().UTC().Format(time.RFC3339), "channel": "email",
+	})
+}
+
+// paymentRequestOut renders pr as create and update return it, with the
+// customer's ID in place of the customer
+func paymentRequestOut(pr object) object {
+	out := copyObject(pr)
+	out["customer"] = pr["customer"].(object)["id"]
+	return out
+}
+
+func (s *Server) createPaymentRequest(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "customer") {
+		return
+	}
+	pr := snakeCase(s.newObject())
+	pr["currency"] = "NGN"
+	pr["line_items"] = []object{}
+	pr["tax"] = []object{}
+	pr["notifications"] = []object{}
+	pr["metadata"] = nil
+	pr["paid"] = false
+	pr["paid_at"] = nil
+	pr["archived"] = false
+	pr["has_invoice"] = false
+	if !s.setPaymentRequest(w, pr, r.body) {
+		return
+	}
+	pr["request_code"] = code("PRQ", pr["id"])
+	pr["status"] = "pending"
+	if draft, _ := r.body["draft"].(bool); draft {
+		pr["status"] = "draft"
+	} else {
+		s.notify(pr, r.body)
+	}
+	s.paymentRequests = append(s.paymentRequests, pr)
+	writeData(w, http.StatusOK, "Payment request created", paymentRequestOut(pr))
+}
+
+func (s *Server) listPaymentRequests(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	requests := filter(newest(s.paymentRequests), func(o object) bool {
+		if o["archived"] == true && q.Get("include_archive") != "true" {
+			return false
+		}
+		if v := q.Get("customer"); v != "" && fmt.Sprint(o["customer"].(object)["id"]) != v {
+			return false
+		}
+		if v := q.Get("status"); v != "" && o["status"] != v {
+			return false
+		}
+		if v := q.Get("currency"); v != "" && o["currency"] != v {
+			return false
+		}
+		return inRange(r, o)
+	})
+	writeList(w, r, "Payment requests retrieved", requests)
+}
+
+func (s *Server) getPaymentRequest(w http.ResponseWriter, r *request, idOrCode string) {
+	pr := find(s.paymentRequests, idOrCode, "request_code")
+	if pr == nil {
+		writeError(w, http.StatusNotFound, "Payment request not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Payment request retrieved", pr)
+}
+
+func (s *Server) verifyPaymentRequest(w http.ResponseWriter, r *request, code string) {
+	pr := find(s.paymentRequests, code, "request_code")
+	if pr == nil {
+		writeError(w, http.StatusNotFound, "Payment request not found")
+		return
+	}
+	out := copyObject(pr)
+	out["pending_amount"] = pr["amount"]
+	if pr["paid"] == true {
+		out["pending_amount"] = 0
+	}
+	writeData(w, http.StatusOK, "Payment request retrieved", out)
+}
+
+func (s *Server) updatePaymentRequest(w http.ResponseWriter, r *request, idOrCode string) {
+	pr := find(s.paymentRequests, idOrCode, "request_code")
+	if pr == nil {
+		writeError(w, http.StatusNotFound, "Payment request not found")
+		return
+	}
+	if pr["paid"] == true || pr["archived"] == true {
+		writeError(w, http.StatusBadRequest, "Payment request can no longer be updated")
+		return
+	}
+	if !s.setPaymentRequest(w, pr, r.body) {
+		return
+	}
+	s.touchSnake(pr)
+	writeData(w, http.StatusOK, "Payment request updated", paymentRequestOut(pr))
+}
+
+func (s *Server) notifyPaymentRequest(w http.ResponseWriter, r *request, code string) {
+	pr := find(s.paymentRequests, code, "request_code")
+	if pr == nil {
+		writeError(w, http.StatusNotFound, "Payment request not found")
+		return
+	}
+	if pr["status"] != "pending" || pr["archived"] == true {
+		writeError(w, http.StatusBadRequest, "Only pending payment requests can be sent")
+		return
+	}
+	s.notify(pr, object{})
+	writeMessage(w, "Notification sent")
+}
+
+func (s *Server) finalizePaymentRequest(w http.ResponseWriter, r *request, code string) {
+	pr := find(s.paymentRequests, code, "request_code")
+	if pr == nil {
+		writeError(w, http.StatusNotFound, "Payment request not found")
+		return
+	}
+	if pr["status"] != "draft" {
+		writeError(w, http.StatusBadRequest, "Payment request has already been finalized")
+		return
+	}
+	pr["status"] = "pending"
+	s.notify(pr, r.body)
+	s.touchSnake(pr)
+	writeData(w, http.StatusOK, "Payment request finalized", pr)
+}
+
+func (s *Server) archivePaymentRequest(w http.ResponseWriter, r *request, code string) {
+	pr := find(s.paymentRequests, code, "request_code")
+	if pr == nil {
+		writeError(w, http.StatusNotFound, "Payment request not found")
+		return
+	}
+	pr["archived"] = true
+	s.touchSnake(pr)
+	writeMessage(w, "Payment request has been archived")
+}
+
+func (s *Server) paymentRequestTotals(w http.ResponseWriter, r *request) {
+	pending, successful, total := map[string]int64{}, map[string]int64{}, map[string]int64{}
+	for _, pr := range s.paymentRequests {
+		if pr["archived"] == true || pr["status"] == "draft" {
+			continue
+		}
+		cur, amt := pr["currency"].(string), pr["amount"].(int64)
+		total[cur] += amt
+		if pr["paid"] == true {
+			successful[cur] += amt
+		} else {
+			pending[cur] += amt
+		}
+	}
+	byCurrency := func(m map[string]int64) []object {
+		out := []object{}
+		for _, cur := range sortedKeys(m) {
+			out = append(out, object{"currency": cur, "amount": m[cur]})
+		}
+		return out
+	}
+	writeData(w, http.StatusOK, "Payment request totals", object{
+		"pending":    byCurrency(pending),
+		"successful": byCurrency(successful),
+		"total":      byCurrency(total),
+	})
+}
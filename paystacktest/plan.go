@@ -0,0 +1,202 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var intervals = map[string]bool{
+	"hourly": true, "daily": true, "weekly": true, "monthly": true,
+	"quarterly": true, "biannually": true, "annually": true,
+}
+
+func (s *Server) createPlan(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "name", "interval") {
+		return
+	}
+	amt, ok := amount(w, r.body)
+	if !ok {
+		return
+	}
+	if !intervals[str(r.body, "interval")] {
+		writeInvalid(w, "interval", "Interval is invalid")
+		return
+	}
+	plan := s.newObject()
+	plan["plan_code"] = code("PLN", plan["id"])
+	plan["amount"] = amt
+	plan["currency"] = "NGN"
+	plan["send_invoices"] = true
+	plan["send_sms"] = true
+	plan["hosted_page"] = false
+	merge(plan, r.body, "name", "description", "interval", "currency", "send_invoices", "send_sms", "invoice_limit")
+	s.plans = append(s.plans, plan)
+	writeData(w, http.StatusCreated, "Plan created", plan)
+}
+
+func (s *Server) getPlan(w http.ResponseWriter, r *request, idOrCode string) {
+	plan := find(s.plans, idOrCode, "plan_code")
+	if plan == nil {
+		writeError(w, http.StatusNotFound, "Plan not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Plan retrieved", plan)
+}
+
+func (s *Server) updatePlan(w http.ResponseWriter, r *request, idOrCode string) {
+	plan := find(s.plans, idOrCode, "plan_code")
+	if plan == nil {
+		writeError(w, http.StatusNotFound, "Plan not found")
+		return
+	}
+	if v := str(r.body, "interval"); v != "" && !intervals[v] {
+		writeInvalid(w, "interval", "Interval is invalid")
+		return
+	}
+	if _, ok := r.body["amount"]; ok {
+		amt, ok := amount(w, r.body)
+		if !ok {
+			return
+		}
+		plan["amount"] = amt
+	}
+	merge(plan, r.body, "name", "description", "interval", "currency", "send_invoices", "send_sms", "invoice_limit")
+	s.touch(plan)
+	affected := len(filter(s.subscriptions, func(o object) bool { return o["plan"] == plan["plan_code"] }))
+	writeMessage(w, fmt.Sprintf("Plan updated. %d subscription(s) affected", affected))
+}
+
+func (s *Server) listPlans(w http.ResponseWriter, r *request) {
+	writeList(w, r, "Plans retrieved", newest(s.plans))
+}
+
+func (s *Server) createSubscription(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "customer", "plan") {
+		return
+	}
+	cust := find(s.customers, str(r.body, "customer"), "customer_code", "email")
+	if cust == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	plan := find(s.plans, str(r.body, "plan"), "plan_code")
+	if plan == nil {
+		writeError(w, http.StatusNotFound, "Plan not found")
+		return
+	}
+	auths, _ := cust["authorizations"].([]object)
+	var auth object
+	if v := str(r.body, "authorization"); v != "" {
+		for _, a := range auths {
+			if a["authorization_code"] == v {
+				auth = a
+			}
+		}
+		if auth == nil {
+			writeError(w, http.StatusBadRequest, "Authorization is invalid")
+			return
+		}
+	} else if len(auths) > 0 {
+		auth = auths[len(auths)-1]
+	} else {
+		writeError(w, http.StatusBadRequest, "This customer has no saved authorizations")
+		return
+	}
+
+	start := s.now().UTC()
+	if v := str(r.body, "start"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			writeInvalid(w, "start", "Start date is invalid")
+			return
+		}
+		start = t
+	}
+	sub := s.newObject()
+	sub["subscription_code"] = code("SUB", sub["id"])
+	sub["email_token"] = strings.ToLower(code("tok", sub["id"]))
+	sub["customer"] = cust["id"]
+	sub["plan"] = plan["plan_code"]
+	sub["authorization"] = auth["authorization_code"]
+	sub["amount"] = plan["amount"]
+	sub["quantity"] = 1
+	sub["status"] = "active"
+	sub["start"] = start.Unix()
+	sub["next_payment_date"] = nextPayment(start, fmt.Sprint(plan["interval"])).Format(time.RFC3339)
+	sub["cron_expression"] = fmt.Sprintf("%d %d %d * *", start.Minute(), start.Hour(), start.Day())
+	sub["invoices"] = []object{}
+	s.subscriptions = append(s.subscriptions, sub)
+	writeData(w, http.StatusOK, "Subscription successfully created", sub)
+}
+
+func nextPayment(t time.Time, interval string) time.Time {
+	switch interval {
+	case "hourly":
+		return t.Add(time.Hour)
+	case "daily":
+		return t.AddDate(0, 0, 1)
+	case "weekly":
+		return t.AddDate(0, 0, 7)
+	case "quarterly":
+		return t.AddDate(0, 3, 0)
+	case "biannually":
+		return t.AddDate(0, 6, 0)
+	case "annually":
+		return t.AddDate(1, 0, 0)
+	}
+	return t.AddDate(0, 1, 0)
+}
+
+func (s *Server) getSubscription(w http.ResponseWriter, r *request, idOrCode string) {
+	sub := find(s.subscriptions, idOrCode, "subscription_code")
+	if sub == nil {
+		writeError(w, http.StatusNotFound, "Subscription not found")
+		return
+	}
+	out := copyObject(sub)
+	if cust := find(s.customers, fmt.Sprint(sub["customer"])); cust != nil {
+		out["customer"] = cust
+	}
+	writeData(w, http.StatusOK, "Subscription retrieved successfully", out)
+}
+
+func (s *Server) listSubscriptions(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	subs := filter(newest(s.subscriptions), func(o object) bool {
+		if v := q.Get("customer"); v != "" && fmt.Sprint(o["customer"]) != v {
+			return false
+		}
+		if v := q.Get("plan"); v != "" {
+			plan := find(s.plans, v)
+			if plan == nil || o["plan"] != plan["plan_code"] {
+				return false
+			}
+		}
+		return true
+	})
+	writeList(w, r, "Subscriptions retrieved", subs)
+}
+
+func (s *Server) enableSubscription(w http.ResponseWriter, r *request) {
+	s.toggleSubscription(w, r, "active", "Subscription enabled successfully")
+}
+
+func (s *Server) disableSubscription(w http.ResponseWriter, r *request) {
+	s.toggleSubscription(w, r, "cancelled", "Subscription disabled successfully")
+}
+
+func (s *Server) toggleSubscription(w http.ResponseWriter, r *request, status, message string) {
+	if !require(w, r.body, "code", "token") {
+		return
+	}
+	sub := find(s.subscriptions, str(r.body, "code"), "subscription_code")
+	if sub == nil || sub["email_token"] != str(r.body, "token") {
+		writeError(w, http.StatusNotFound, "Subscription not found")
+		return
+	}
+	sub["status"] = status
+	s.touch(sub)
+	writeMessage(w, message)
+}
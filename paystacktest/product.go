@@ -0,0 +1,121 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// setStock applies the quantity and unlimited fields of body to product
+func setStock(w http.ResponseWriter, product, body object) bool {
+	if _, ok := body["quantity"]; ok {
+		n, ok := integer(body, "quantity")
+		if !ok || n < 0 {
+			writeInvalid(w, "quantity", "Quantity must be a positive number")
+			return false
+		}
+		product["quantity"] = n
+	}
+	merge(product, body, "unlimited")
+	product["in_stock"] = product["unlimited"] == true || product["quantity"].(int64) > 0
+	return true
+}
+
+// price reads a positive price in minor units from body
+func price(w http.ResponseWriter, body object) (int64, bool) {
+	n, ok := integer(body, "price")
+	if !ok || n <= 0 {
+		writeInvalid(w, "price", "Invalid price passed")
+		return 0, false
+	}
+	return n, true
+}
+
+func (s *Server) createProduct(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "name", "price") {
+		return
+	}
+	amt, ok := price(w, r.body)
+	if !ok {
+		return
+	}
+	product := s.newObject()
+	product["price"] = amt
+	product["currency"] = "NGN"
+	product["product_code"] = code("PROD", product["id"])
+	product["slug"] = fmt.Sprintf("%s-%d", strings.Trim(nonSlug.ReplaceAllString(strings.ToLower(str(r.body, "name")), "-"), "-"), product["id"])
+	product["quantity"] = int64(0)
+	product["quantity_sold"] = 0
+	product["unlimited"] = false
+	product["type"] = "good"
+	product["active"] = true
+	product["metadata"] = nil
+	if !setStock(w, product, r.body) {
+		return
+	}
+	merge(product, r.body, "name", "description", "currency", "metadata")
+	s.products = append(s.products, product)
+	writeData(w, http.StatusOK, "Product successfully created", product)
+}
+
+func (s *Server) getProduct(w http.ResponseWriter, r *request, id string) {
+	product := find(s.products, id, "product_code")
+	if product == nil {
+		writeError(w, http.StatusNotFound, "Product not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Product retrieved", product)
+}
+
+func (s *Server) updateProduct(w http.ResponseWriter, r *request, id string) {
+	product := find(s.products, id, "product_code")
+	if product == nil {
+		writeError(w, http.StatusNotFound, "Product not found")
+		return
+	}
+	if _, ok := r.body["price"]; ok {
+		amt, ok := price(w, r.body)
+		if !ok {
+			return
+		}
+		product["price"] = amt
+	}
+	if !setStock(w, product, r.body) {
+		return
+	}
+	merge(product, r.body, "name", "description", "currency", "active", "metadata")
+	s.touch(product)
+	writeData(w, http.StatusOK, "Product successfully updated", product)
+}
+
+func (s *Server) listProducts(w http.ResponseWriter, r *request) {
+	products := filter(newest(s.products), func(o object) bool { return inRange(r, o) })
+	writeList(w, r, "Products retrieved", products)
+}
+
+func (s *Server) addPageProducts(w http.ResponseWriter, r *request, idOrSlug string) {
+	page := find(s.pages, idOrSlug, "slug")
+	if page == nil {
+		writeError(w, http.StatusNotFound, "Page not found")
+		return
+	}
+	ids, _ := r.body["product"].([]interface{})
+	if len(ids) == 0 {
+		writeInvalid(w, "product", "Product is required")
+		return
+	}
+	products, _ := page["products"].([]object)
+	for _, id := range ids {
+		product := find(s.products, fmt.Sprint(id))
+		if product == nil {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Product %v not found", id))
+			return
+		}
+		if find(products, fmt.Sprint(id)) == nil {
+			products = append(products, copyObject(product))
+		}
+	}
+	page["products"] = products
+	s.touch(page)
+	writeData(w, http.StatusOK, "Products added to page", page)
+}
@@ -0,0 +1,120 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// CompleteRefund marks the refund with the given ID as processed, as Paystack
+// does once the money is back with the customer
+func (s *Server) CompleteRefund(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	refund := find(s.refunds, fmt.Sprint(id))
+	if refund == nil {
+		return fmt.Errorf("paystacktest: no refund with id %d", id)
+	}
+	refund["status"] = "processed"
+	refund["refunded_at"] = s.now().UTC().Format(time.RFC3339)
+	s.touch(refund)
+	return nil
+}
+
+// refunded returns the amount of txn already refunded or being refunded
+func (s *Server) refunded(txn object) int64 {
+	var total int64
+	for _, r := range s.refunds {
+		if r["transaction"] == txn["id"] && r["status"] != "failed" {
+			total += r["amount"].(int64)
+		}
+	}
+	return total
+}
+
+func (s *Server) createRefund(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "transaction") {
+		return
+	}
+	txn := find(s.transactions, str(r.body, "transaction"), "reference")
+	if txn == nil {
+		writeError(w, http.StatusNotFound, "Transaction not found")
+		return
+	}
+	if txn["status"] != "success" && txn["status"] != "reversed" {
+		writeError(w, http.StatusBadRequest, "Cannot refund a transaction that was not successful")
+		return
+	}
+	if cur := str(r.body, "currency"); cur != "" && cur != txn["currency"] {
+		writeInvalid(w, "currency", "Currency does not match the transaction currency")
+		return
+	}
+	remaining := txn["amount"].(int64) - s.refunded(txn)
+	if remaining <= 0 {
+		writeError(w, http.StatusBadRequest, "Transaction has been fully reversed")
+		return
+	}
+	amt := remaining
+	if _, ok := r.body["amount"]; ok {
+		if amt, ok = amount(w, r.body); !ok {
+			return
+		}
+		if amt > remaining {
+			writeInvalid(w, "amount", "Refund amount cannot be more than the unrefunded transaction amount")
+			return
+		}
+	}
+
+	refund := s.newObject()
+	refund["transaction"] = txn["id"]
+	refund["amount"] = amt
+	refund["deducted_amount"] = amt
+	refund["fully_deducted"] = true
+	refund["currency"] = txn["currency"]
+	refund["channel"] = "card"
+	refund["status"] = "pending"
+	refund["refunded_by"] = "test@example.com"
+	refund["expected_at"] = s.now().Add(10 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	merge(refund, r.body, "customer_note", "merchant_note")
+	s.refunds = append(s.refunds, refund)
+	if amt == remaining {
+		txn["status"] = "reversed"
+		s.touch(txn)
+	}
+
+	out := copyObject(refund)
+	out["transaction"] = copyObject(txn)
+	writeData(w, http.StatusOK, "Refund has been queued for processing", out)
+}
+
+func (s *Server) getRefund(w http.ResponseWriter, r *request, id string) {
+	refund := find(s.refunds, id)
+	if refund == nil {
+		writeError(w, http.StatusNotFound, "Refund not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Refund retrieved", refund)
+}
+
+func (s *Server) listRefunds(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	var txnID interface{}
+	if v := q.Get("transaction"); v != "" {
+		txn := find(s.transactions, v, "reference")
+		if txn == nil {
+			writeList(w, r, "Refunds retrieved", []object{})
+			return
+		}
+		txnID = txn["id"]
+	}
+	refunds := filter(newest(s.refunds), func(o object) bool {
+		if txnID != nil && o["transaction"] != txnID {
+			return false
+		}
+		if v := q.Get("currency"); v != "" && o["currency"] != v {
+			return false
+		}
+		return inRange(r, o)
+	})
+	writeList(w, r, "Refunds retrieved", refunds)
+}
@@ -0,0 +1,154 @@
+package paystacktest
+
+import "strings"
+
+// Step is a status a charge passes through in a Scenario
+type Step string
+
+// Charge steps. The send_* steps wait for the matching ChargeService submit
+// call; pending and timeout wait for the charge to be polled.
+const (
+	StepPIN      Step = "send_pin"
+	StepOTP      Step = "send_otp"
+	StepPhone    Step = "send_phone"
+	StepBirthday Step = "send_birthday"
+	StepPending  Step = "pending"
+	StepSuccess  Step = "success"
+	StepFailed   Step = "failed"
+	// StepTimeout holds the request that reaches it open until the client
+	// gives up. The charge is left pending, and the next poll moves it on.
+	StepTimeout Step = "timeout"
+)
+
+// Scenario scripts the course of a charge
+type Scenario struct {
+	// Steps are taken in order. A charge starts at the first; submitting what
+	// a send_* step asks for, or polling a pending or timed out charge, moves
+	// it to the next. A charge that runs out of steps stays where it is.
+	Steps []Step
+	// PIN is the only PIN accepted at StepPIN. If empty any PIN is accepted.
+	PIN string
+	// Message is the gateway response of a failed charge. It defaults to "Declined".
+	Message string
+}
+
+// PendingThen returns a scenario in which the charge is pending when
+// created and reaches final on the polls-th poll
+func PendingThen(polls int, final Step) Scenario {
+	steps := make([]Step, 0, polls+1)
+	for i := 0; i < polls; i++ {
+		steps = append(steps, StepPending)
+	}
+	return Scenario{Steps: append(steps, final)}
+}
+
+// TestCards maps the card numbers the fake recognises to their scenarios.
+// They are modelled on the cards in Paystack's test payments documentation.
+// Other card numbers are charged successfully without further steps.
+var TestCards = map[string]Scenario{
+	// no validation
+	"4084084084084081": {Steps: []Step{StepSuccess}},
+	// PIN and OTP
+	"507850785078507812": {Steps: []Step{StepPIN, StepOTP, StepSuccess}, PIN: "1111"},
+	// PIN, phone number and OTP
+	"50785078507850784": {Steps: []Step{StepPIN, StepPhone, StepOTP, StepSuccess}, PIN: "0000"},
+	// PIN only
+	"5060666666666666666": {Steps: []Step{StepPIN, StepSuccess}, PIN: "1234"},
+	// declined by the issuer
+	"4084080000000409": {Steps: []Step{StepFailed}, Message: "Declined"},
+}
+
+// flow is a charge following a scenario
+type flow struct {
+	Scenario
+	pos int
+}
+
+func (f *flow) step() Step {
+	if len(f.Steps) == 0 {
+		return StepSuccess
+	}
+	return f.Steps[f.pos]
+}
+
+// next moves f to its next step, reporting false if it has none
+func (f *flow) next() bool {
+	if f.pos+1 >= len(f.Steps) {
+		return false
+	}
+	f.pos++
+	return true
+}
+
+// SetCard makes charges to the card with the given number follow sc
+func (s *Server) SetCard(number string, sc Scenario) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cards[number] = sc
+}
+
+// NextCharge makes the next charge created follow sc, whatever it is
+// paid with. Scenarios queue up, one per charge.
+func (s *Server) NextCharge(sc Scenario) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scripted = append(s.scripted, sc)
+}
+
+// scenarioFor picks the scenario of a new charge
+func (s *Server) scenarioFor(card, bank object) Scenario {
+	if len(s.scripted) > 0 {
+		sc := s.scripted[0]
+		s.scripted = s.scripted[1:]
+		return sc
+	}
+	if card != nil {
+		number := strings.ReplaceAll(str(card, "card_number"), " ", "")
+		if sc, ok := s.cards[number]; ok {
+			return sc
+		}
+		if sc, ok := TestCards[number]; ok {
+			return sc
+		}
+	}
+	if bank != nil {
+		return Scenario{Steps: []Step{StepBirthday, StepOTP, StepSuccess}}
+	}
+	return Scenario{Steps: []Step{StepSuccess}}
+}
+
+var displayText = map[Step]string{
+	StepOTP:      "Please enter the OTP sent to your phone",
+	StepPhone:    "Please enter your phone number",
+	StepBirthday: "Please enter your birthday",
+}
+
+// enter puts txn at the current step of f, returning false if the request
+// should be held open for a timeout
+func (s *Server) enter(txn object, f *flow) bool {
+	step := f.step()
+	delete(txn, "display_text")
+	switch step {
+	case StepSuccess:
+		s.succeed(txn, txn["authorization"].(object))
+	case StepFailed:
+		msg := f.Message
+		if msg == "" {
+			msg = "Declined"
+		}
+		txn["status"] = "failed"
+		txn["gateway_response"] = msg
+		txn["message"] = msg
+	case StepTimeout:
+		txn["status"] = string(StepPending)
+		s.touch(txn)
+		return false
+	default:
+		txn["status"] = string(step)
+		if text, ok := displayText[step]; ok {
+			txn["display_text"] = text
+		}
+	}
+	s.touch(txn)
+	return true
+}
@@ -0,0 +1,173 @@
+package paystacktest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	paystack "github.com/rpip/paystack-go"
+	"github.com/rpip/paystack-go/paystacktest"
+)
+
+func card(number string) *paystack.Card {
+	return &paystack.Card{Number: number, CVV: "408", ExpirtyMonth: "12", ExpiryYear: "2030"}
+}
+
+func TestChargePINThenOTP(t *testing.T) {
+	client, _ := newClient(t)
+
+	resp, err := client.Charge.Create(&paystack.ChargeRequest{
+		Email: "ada@example.com", Amount: 10000, Card: card("507850785078507812"),
+	})
+	if err != nil || resp.Status != "send_pin" {
+		t.Fatalf("Expected send_pin, got %v, %v", resp, err)
+	}
+	ref := resp.Reference
+
+	if _, err := client.Charge.SubmitOTP(paystacktest.OTP, ref); err == nil {
+		t.Error("Expected OTP to be refused before PIN")
+	}
+	if _, err := client.Charge.SubmitPIN("9999", ref); err == nil {
+		t.Error("Expected incorrect PIN to be refused")
+	}
+
+	resp, err = client.Charge.SubmitPIN("1111", ref)
+	if err != nil || resp.Status != "send_otp" || resp.DisplayText == "" {
+		t.Fatalf("Expected send_otp with display text, got %v, %v", resp, err)
+	}
+	if _, err := client.Charge.SubmitOTP("000000", ref); err == nil {
+		t.Error("Expected invalid OTP to be refused")
+	}
+	resp, err = client.Charge.SubmitOTP(paystacktest.OTP, ref)
+	if err != nil || resp.Status != "success" {
+		t.Fatalf("Expected success, got %v, %v", resp, err)
+	}
+
+	txn, err := client.Transaction.Verify(ref)
+	if err != nil || txn.Status != "success" {
+		t.Errorf("Expected verified transaction, got %+v, %v", txn, err)
+	}
+}
+
+func TestChargePINUpFront(t *testing.T) {
+	client, _ := newClient(t)
+	resp, err := client.Charge.Create(&paystack.ChargeRequest{
+		Email: "ada@example.com", Amount: 10000, Card: card("5060666666666666666"), Pin: "1234",
+	})
+	if err != nil || resp.Status != "success" {
+		t.Errorf("Expected PIN in the request to complete the charge, got %v, %v", resp, err)
+	}
+
+	// a wrong PIN leaves no transaction behind, so the reference can be retried
+	req := &paystack.ChargeRequest{
+		Email: "ada@example.com", Amount: 10000, Card: card("5060666666666666666"), Pin: "9999", Reference: "retry-pin",
+	}
+	if _, err := client.Charge.Create(req); err == nil {
+		t.Fatal("Expected incorrect PIN to be refused")
+	}
+	if _, err := client.Transaction.Verify("retry-pin"); !errors.Is(err, paystack.ErrNotFound) {
+		t.Errorf("Expected no transaction for the refused charge, got %v", err)
+	}
+	req.Pin = "1234"
+	if resp, err := client.Charge.Create(req); err != nil || resp.Status != "success" {
+		t.Errorf("Expected the retried charge to succeed, got %v, %v", resp, err)
+	}
+}
+
+func TestChargePhoneStep(t *testing.T) {
+	client, _ := newClient(t)
+	resp, _ := client.Charge.Create(&paystack.ChargeRequest{
+		Email: "ada@example.com", Amount: 10000, Card: card("50785078507850784"), Pin: "0000",
+	})
+	if resp.Status != "send_phone" {
+		t.Fatalf("Expected send_phone, got %v", resp)
+	}
+	ref := resp.Reference
+	resp, _ = client.Charge.SubmitPhone("08012345678", ref)
+	if resp.Status != "send_otp" {
+		t.Fatalf("Expected send_otp, got %v", resp)
+	}
+	resp, _ = client.Charge.SubmitOTP(paystacktest.OTP, ref)
+	if resp.Status != "success" {
+		t.Errorf("Expected success, got %v", resp)
+	}
+}
+
+func TestChargeBankBirthday(t *testing.T) {
+	client, _ := newClient(t)
+	bank := &paystack.BankAccount{Code: "057", AccountNumber: "0000000000"}
+
+	resp, _ := client.Charge.Create(&paystack.ChargeRequest{Email: "ada@example.com", Amount: 10000, Bank: bank})
+	if resp.Status != "send_birthday" {
+		t.Fatalf("Expected send_birthday, got %v", resp)
+	}
+	ref := resp.Reference
+	if _, err := client.Charge.SubmitBirthday("31/12/1999", ref); err == nil {
+		t.Error("Expected malformed birthday to be refused")
+	}
+	resp, _ = client.Charge.SubmitBirthday("1999-12-31", ref)
+	if resp.Status != "send_otp" {
+		t.Errorf("Expected send_otp, got %v", resp)
+	}
+}
+
+func TestChargeDeclined(t *testing.T) {
+	client, _ := newClient(t)
+	resp, err := client.Charge.Create(&paystack.ChargeRequest{
+		Email: "ada@example.com", Amount: 10000, Card: card("4084080000000409"),
+	})
+	if err != nil || resp.Status != "failed" || resp.GatewayResponse != "Declined" {
+		t.Errorf("Expected declined charge, got %v, %v", resp, err)
+	}
+}
+
+func TestChargePendingThenSuccess(t *testing.T) {
+	client, srv := newClient(t)
+	srv.NextCharge(paystacktest.PendingThen(2, paystacktest.StepSuccess))
+
+	resp, _ := client.Charge.Create(&paystack.ChargeRequest{
+		Email: "ada@example.com", Amount: 10000, Card: card("4084084084084081"),
+	})
+	ref := resp.Reference
+	for i, want := range []string{"pending", "pending", "success", "success"} {
+		if i > 0 {
+			resp, _ = client.Charge.CheckPending(ref)
+		}
+		if resp.Status != want {
+			t.Fatalf("Poll %d: expected %s, got %v", i, want, resp.Status)
+		}
+	}
+}
+
+func TestChargeTimeout(t *testing.T) {
+	client, srv := newClient(t)
+	srv.NextCharge(paystacktest.Scenario{Steps: []paystacktest.Step{paystacktest.StepTimeout, paystacktest.StepSuccess}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req := &paystack.ChargeRequest{Email: "ada@example.com", Amount: 10000, Card: card("4084084084084081")}
+	_, err := client.Charge.CreateWithContext(ctx, req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the charge to time out, got %v", err)
+	}
+
+	resp, err := client.Charge.CheckPending(req.Reference)
+	if err != nil || resp.Status != "success" {
+		t.Errorf("Expected the timed out charge to resolve on the next poll, got %v, %v", resp, err)
+	}
+}
+
+func TestSetCard(t *testing.T) {
+	client, srv := newClient(t)
+	srv.SetCard("4111111111111111", paystacktest.Scenario{
+		Steps:   []paystacktest.Step{paystacktest.StepFailed},
+		Message: "Insufficient Funds",
+	})
+	resp, _ := client.Charge.Create(&paystack.ChargeRequest{
+		Email: "ada@example.com", Amount: 10000, Card: card("4111111111111111"),
+	})
+	if resp.GatewayResponse != "Insufficient Funds" {
+		t.Errorf("Expected scripted failure, got %v", resp)
+	}
+}
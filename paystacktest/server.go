@@ -0,0 +1,673 @@
+// Package paystacktest provides an in-process fake of the Paystack API for
+// testing code that uses the paystack package without network access or a
+// secret key.
+//
+// The fake keeps its state in memory and answers with the same envelopes as
+// the live API: {"status": true, "message": ..., "data": ...} on success and
+// {"status": false, "message": ...} with a 4xx status on failure. Point a
+// client at it with the base URL option:
+//
+//	srv := paystacktest.NewServer()
+//	defer srv.Close()
+//
+//	client, _ := paystack.NewClientWithOptions("sk_test_xxx", paystack.WithBaseURL(srv.URL))
+//
+// Any secret key starting with "sk_" is accepted. Transfers that need an OTP
+// are finalized with the code in OTP.
+//
+// Charges made through ChargeService follow a Scenario: the card numbers in
+// TestCards ask for a PIN, an OTP and so on, and NextCharge and SetCard
+// script other flows such as a charge that stays pending for a few polls.
+package paystacktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OTP is the one-time password the fake accepts wherever Paystack asks for one
+const OTP = "123456"
+
+// DefaultBalance is the NGN balance, in kobo, a new Server starts with
+const DefaultBalance = 100000000
+
+// object is a JSON object as stored and returned by the fake
+type object = map[string]interface{}
+
+// Server is a fake Paystack API listening on a local address
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	now      func() time.Time
+	nextID   int
+	failures []failure
+	cards    map[string]Scenario
+	scripted []Scenario
+	flows    map[string]*flow
+
+	balances       map[string]int64
+	sessionTimeout int
+	transferOTP    bool
+
+	banks             []object
+	accounts          map[string]string
+	transactions      []object
+	customers         []object
+	plans             []object
+	subscriptions     []object
+	transfers         []object
+	recipients        []object
+	pages             []object
+	subaccounts       []object
+	batches           []object
+	bulkCharges       []object
+	refunds           []object
+	disputes          []object
+	dedicatedAccounts []object
+	paymentRequests   []object
+	products          []object
+}
+
+type failure struct {
+	method, path string
+	status       int
+	message      string
+}
+
+// NewServer starts and returns a new fake Paystack server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		now:         time.Now,
+		balances:    map[string]int64{"NGN": DefaultBalance},
+		transferOTP: true,
+		cards:       map[string]Scenario{},
+		flows:       map[string]*flow{},
+		banks:       defaultBanks(),
+		accounts: map[string]string{
+			"058/0001234560": "Test Account",
+			"044/0193278965": "Sunshine Studios",
+		},
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// SetBalance sets the integration balance for currency, in minor units
+func (s *Server) SetBalance(currency string, amount int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.balances[currency] = amount
+}
+
+// Balance returns the integration balance for currency, in minor units
+func (s *Server) Balance(currency string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.balances[currency]
+}
+
+// AddAccount registers a bank account that resolves to name and can be used
+// for transfer recipients and subaccounts
+func (s *Server) AddAccount(bankCode, accountNumber, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[bankCode+"/"+accountNumber] = name
+}
+
+// FailNext makes the next request matching method and path fail with the
+// given HTTP status and message, before reaching the fake's own handling.
+// Failures queue up, so calling it twice fails the next two matching requests.
+func (s *Server) FailNext(method, path string, status int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{method, "/" + strings.TrimPrefix(path, "/"), status, message})
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, "/exports/") {
+		s.serveExport(w, r)
+		return
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer sk_") {
+		writeError(w, http.StatusUnauthorized, "Invalid key")
+		return
+	}
+
+	s.mu.Lock()
+	req := s.handle(w, r)
+	s.mu.Unlock()
+
+	if req != nil && req.hold {
+		<-r.Context().Done()
+	}
+}
+
+// handle answers r with the lock held. It returns the request as passed to
+// the handler, if any.
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) *request {
+	for i, f := range s.failures {
+		if f.method == r.Method && f.path == r.URL.Path {
+			s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			writeError(w, f.status, f.message)
+			return nil
+		}
+	}
+
+	var body object
+	if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodDelete) {
+		dec := json.NewDecoder(r.Body)
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil && err.Error() != "EOF" {
+			writeError(w, http.StatusBadRequest, "Invalid JSON in request body")
+			return nil
+		}
+		switch t := v.(type) {
+		case map[string]interface{}:
+			body = t
+		case []interface{}:
+			body = object{"items": t}
+		}
+	}
+	if body == nil {
+		body = object{}
+	}
+
+	h, ok := s.route(r.Method, strings.Split(strings.Trim(r.URL.Path, "/"), "/"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "Route not found")
+		return nil
+	}
+	req := &request{Request: r, body: body}
+	h(w, req)
+	return req
+}
+
+// request is an incoming request with its decoded JSON body
+type request struct {
+	*http.Request
+	body object
+	// hold is set by handlers that leave the request unanswered until the
+	// client gives up
+	hold bool
+}
+
+type handler func(w http.ResponseWriter, r *request)
+
+// route picks the handler for method and the path segments, binding any
+// path parameter to it
+func (s *Server) route(method string, p []string) (handler, bool) {
+	with := func(h func(http.ResponseWriter, *request, string), v string) handler {
+		return func(w http.ResponseWriter, r *request) { h(w, r, v) }
+	}
+	key := method + " " + p[0]
+	switch len(p) {
+	case 1:
+		switch key {
+		case "GET transaction":
+			return s.listTransactions, true
+		case "GET customer":
+			return s.listCustomers, true
+		case "POST customer":
+			return s.createCustomer, true
+		case "GET plan":
+			return s.listPlans, true
+		case "POST plan":
+			return s.createPlan, true
+		case "GET subscription":
+			return s.listSubscriptions, true
+		case "POST subscription":
+			return s.createSubscription, true
+		case "GET transfer":
+			return s.listTransfers, true
+		case "POST transfer":
+			return s.initiateTransfer, true
+		case "GET transferrecipient":
+			return s.listRecipients, true
+		case "POST transferrecipient":
+			return s.createRecipient, true
+		case "GET page":
+			return s.listPages, true
+		case "POST page":
+			return s.createPage, true
+		case "GET subaccount":
+			return s.listSubaccounts, true
+		case "POST subaccount":
+			return s.createSubaccount, true
+		case "GET bulkcharge":
+			return s.listBatches, true
+		case "POST bulkcharge":
+			return s.initiateBulkCharge, true
+		case "GET bank":
+			return s.listBanks, true
+		case "GET balance":
+			return s.balance, true
+		case "GET settlement":
+			return s.listSettlements, true
+		case "POST charge":
+			return s.createCharge, true
+		case "GET refund":
+			return s.listRefunds, true
+		case "POST refund":
+			return s.createRefund, true
+		case "GET dispute":
+			return s.listDisputes, true
+		case "GET dedicated_account":
+			return s.listDedicatedAccounts, true
+		case "GET paymentrequest":
+			return s.listPaymentRequests, true
+		case "POST paymentrequest":
+			return s.createPaymentRequest, true
+		case "GET product":
+			return s.listProducts, true
+		case "POST product":
+			return s.createProduct, true
+		case "POST dedicated_account":
+			return s.createDedicatedAccount, true
+		}
+	case 2:
+		switch key + "/" + p[1] {
+		case "POST transaction/initialize":
+			return s.initializeTransaction, true
+		case "POST transaction/charge_authorization":
+			return s.chargeAuthorization, true
+		case "GET transaction/totals":
+			return s.transactionTotals, true
+		case "GET transaction/export":
+			return s.exportTransactions, true
+		case "POST transaction/request_reauthorization":
+			return s.requestReauthorization, true
+		case "POST transaction/check_reauthorization":
+			return s.checkAuthorization, true
+		case "POST customer/set_risk_action":
+			return s.setRiskAction, true
+		case "POST customer/deactivate_authorization":
+			return s.deactivateAuthorization, true
+		case "POST subscription/enable":
+			return s.enableSubscription, true
+		case "POST subscription/disable":
+			return s.disableSubscription, true
+		case "POST transfer/finalize_transfer":
+			return s.finalizeTransfer, true
+		case "POST transfer/bulk":
+			return s.bulkTransfer, true
+		case "POST transfer/resend_otp":
+			return s.resendOTP, true
+		case "POST transfer/enable_otp":
+			return s.enableOTP, true
+		case "POST transfer/disable_otp":
+			return s.disableOTP, true
+		case "POST transfer/disable_otp_finalize":
+			return s.finalizeDisableOTP, true
+		case "GET bank/resolve":
+			return s.resolveAccount, true
+		case "GET integration/payment_session_timeout":
+			return s.getSessionTimeout, true
+		case "PUT integration/payment_session_timeout":
+			return s.updateSessionTimeout, true
+		case "POST charge/tokenize":
+			return s.tokenize, true
+		case "GET dispute/export":
+			return s.exportDisputes, true
+		case "POST dedicated_account/assign":
+			return s.assignDedicatedAccount, true
+		case "GET dedicated_account/requery":
+			return s.requeryDedicatedAccount, true
+		case "POST dedicated_account/split":
+			return s.addDedicatedAccountSplit, true
+		case "DELETE dedicated_account/split":
+			return s.removeDedicatedAccountSplit, true
+		case "GET dedicated_account/available_providers":
+			return s.availableProviders, true
+		case "GET paymentrequest/totals":
+			return s.paymentRequestTotals, true
+		case "POST charge/submit_pin", "POST charge/submit_otp",
+			"POST charge/submit_phone", "POST charge/submit_birthday":
+			return with(s.submitCharge, strings.TrimPrefix(p[1], "submit_")), true
+		}
+		switch key {
+		case "GET transaction":
+			return with(s.getTransaction, p[1]), true
+		case "GET customer":
+			return with(s.getCustomer, p[1]), true
+		case "PUT customer":
+			return with(s.updateCustomer, p[1]), true
+		case "GET plan":
+			return with(s.getPlan, p[1]), true
+		case "PUT plan":
+			return with(s.updatePlan, p[1]), true
+		case "GET subscription":
+			return with(s.getSubscription, p[1]), true
+		case "GET transfer":
+			return with(s.getTransfer, p[1]), true
+		case "GET page":
+			return with(s.getPage, p[1]), true
+		case "PUT page":
+			return with(s.updatePage, p[1]), true
+		case "GET subaccount":
+			return with(s.getSubaccount, p[1]), true
+		case "PUT subaccount":
+			return with(s.updateSubaccount, p[1]), true
+		case "GET bulkcharge":
+			return with(s.getBatch, p[1]), true
+		case "GET charge":
+			return with(s.checkPending, p[1]), true
+		case "GET refund":
+			return with(s.getRefund, p[1]), true
+		case "GET dispute":
+			return with(s.getDispute, p[1]), true
+		case "PUT dispute":
+			return with(s.updateDispute, p[1]), true
+		case "GET dedicated_account":
+			return with(s.getDedicatedAccount, p[1]), true
+		case "DELETE dedicated_account":
+			return with(s.deactivateDedicatedAccount, p[1]), true
+		case "GET paymentrequest":
+			return with(s.getPaymentRequest, p[1]), true
+		case "PUT paymentrequest":
+			return with(s.updatePaymentRequest, p[1]), true
+		case "GET product":
+			return with(s.getProduct, p[1]), true
+		case "PUT product":
+			return with(s.updateProduct, p[1]), true
+		}
+	case 3:
+		switch key + "/" + p[1] {
+		case "GET transaction/verify":
+			return with(s.verifyTransaction, p[2]), true
+		case "GET transaction/timeline":
+			return with(s.transactionTimeline, p[2]), true
+		case "GET bulkcharge/pause":
+			return with(s.pauseBatch, p[2]), true
+		case "GET bulkcharge/resume":
+			return with(s.resumeBatch, p[2]), true
+		case "GET bank/resolve_bvn":
+			return with(s.resolveBVN, p[2]), true
+		case "GET decision/bin":
+			return with(s.resolveBIN, p[2]), true
+		case "GET dispute/transaction":
+			return with(s.transactionDisputes, p[2]), true
+		case "GET paymentrequest/verify":
+			return with(s.verifyPaymentRequest, p[2]), true
+		case "POST paymentrequest/notify":
+			return with(s.notifyPaymentRequest, p[2]), true
+		case "POST paymentrequest/finalize":
+			return with(s.finalizePaymentRequest, p[2]), true
+		case "POST paymentrequest/archive":
+			return with(s.archivePaymentRequest, p[2]), true
+		}
+		switch key + "/:id/" + p[2] {
+		case "GET bulkcharge/:id/charges":
+			return with(s.batchCharges, p[1]), true
+		case "GET dispute/:id/upload_url":
+			return with(s.disputeUploadURL, p[1]), true
+		case "POST dispute/:id/evidence":
+			return with(s.addEvidence, p[1]), true
+		case "PUT dispute/:id/resolve":
+			return with(s.resolveDispute, p[1]), true
+		case "POST page/:id/product":
+			return with(s.addPageProducts, p[1]), true
+		}
+	}
+	return nil, false
+}
+
+// ENVELOPES
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeData(w http.ResponseWriter, status int, message string, data interface{}) {
+	writeJSON(w, status, object{"status": true, "message": message, "data": data})
+}
+
+func writeMessage(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusOK, object{"status": true, "message": message})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{"status": false, "message": message})
+}
+
+// writeInvalid answers 400 with per-field errors in the shape Paystack uses
+// for failed request validation
+func writeInvalid(w http.ResponseWriter, field, message string) {
+	writeJSON(w, http.StatusBadRequest, object{
+		"status":  false,
+		"message": message,
+		"errors": object{
+			field: []object{{"rule": "required", "message": message}},
+		},
+	})
+}
+
+// writeList answers with one page of items, honouring the perPage and page
+// query parameters and reporting them in meta
+func writeList(w http.ResponseWriter, r *request, message string, items []object) {
+	q := r.URL.Query()
+	perPage, _ := strconv.Atoi(q.Get("perPage"))
+	if perPage <= 0 {
+		perPage = 50
+	}
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page <= 0 {
+		page = 1
+	}
+	start := (page - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+	pageCount := (len(items) + perPage - 1) / perPage
+	writeJSON(w, http.StatusOK, object{
+		"status":  true,
+		"message": message,
+		"data":    items[start:end],
+		"meta": object{
+			"total":     len(items),
+			"skipped":   start,
+			"perPage":   perPage,
+			"page":      page,
+			"pageCount": pageCount,
+		},
+	})
+}
+
+// STATE HELPERS
+
+// newObject returns an object with a fresh id and timestamps
+func (s *Server) newObject() object {
+	s.nextID++
+	now := s.now().UTC().Format(time.RFC3339)
+	return object{
+		"id":          s.nextID,
+		"integration": 100032,
+		"domain":      "test",
+		"createdAt":   now,
+		"updatedAt":   now,
+	}
+}
+
+// code returns a Paystack style resource code such as CUS_0000000007
+func code(prefix string, id interface{}) string {
+	return fmt.Sprintf("%s_%010d", prefix, id)
+}
+
+func (s *Server) touch(o object) {
+	o["updatedAt"] = s.now().UTC().Format(time.RFC3339)
+}
+
+// snakeCase renames the timestamps of a new object to created_at and
+// updated_at, which some Paystack resources use
+func snakeCase(o object) object {
+	o["created_at"], o["updated_at"] = o["createdAt"], o["updatedAt"]
+	delete(o, "createdAt")
+	delete(o, "updatedAt")
+	return o
+}
+
+// touchSnake is touch for objects with snake case timestamps
+func (s *Server) touchSnake(o object) {
+	o["updated_at"] = s.now().UTC().Format(time.RFC3339)
+}
+
+// find returns the first item whose id or any of the given keys equals v
+func find(items []object, v string, keys ...string) object {
+	for _, o := range items {
+		if fmt.Sprint(o["id"]) == v {
+			return o
+		}
+		for _, k := range keys {
+			if s, ok := o[k].(string); ok && s != "" && s == v {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+// newest returns items in reverse creation order, as Paystack lists them
+func newest(items []object) []object {
+	out := make([]object, len(items))
+	for i, o := range items {
+		out[len(items)-1-i] = o
+	}
+	return out
+}
+
+// filter returns the items for which keep is true
+func filter(items []object, keep func(object) bool) []object {
+	out := []object{}
+	for _, o := range items {
+		if keep(o) {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// inRange reports whether o was created within the from and to query parameters
+func inRange(r *request, o object) bool {
+	createdAt, ok := o["createdAt"]
+	if !ok {
+		createdAt = o["created_at"]
+	}
+	created, _ := time.Parse(time.RFC3339, fmt.Sprint(createdAt))
+	q := r.URL.Query()
+	if from, err := time.Parse(time.RFC3339, q.Get("from")); err == nil && created.Before(from) {
+		return false
+	}
+	if to, err := time.Parse(time.RFC3339, q.Get("to")); err == nil && created.After(to) {
+		return false
+	}
+	return true
+}
+
+// copyObject returns a shallow copy of o, so responses don't alias state
+func copyObject(o object) object {
+	c := make(object, len(o))
+	for k, v := range o {
+		c[k] = v
+	}
+	return c
+}
+
+// str returns the string form of body[key]. Form-style values arrive as
+// single-element arrays and are unwrapped.
+func str(body object, key string) string {
+	switch t := body[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case []interface{}:
+		if len(t) > 0 {
+			return str(object{key: t[0]}, key)
+		}
+		return ""
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+// integer returns body[key] as an integer and whether it was a valid one
+func integer(body object, key string) (int64, bool) {
+	switch t := body[key].(type) {
+	case json.Number:
+		n, err := t.Int64()
+		return n, err == nil
+	case string:
+		n, err := strconv.ParseInt(t, 10, 64)
+		return n, err == nil
+	case []interface{}:
+		if len(t) > 0 {
+			return integer(object{key: t[0]}, key)
+		}
+	}
+	return 0, false
+}
+
+// require answers a validation error and returns false if any of fields is
+// missing from body
+func require(w http.ResponseWriter, body object, fields ...string) bool {
+	for _, f := range fields {
+		if str(body, f) == "" {
+			writeInvalid(w, f, strings.ToUpper(f[:1])+strings.ReplaceAll(f[1:], "_", " ")+" is required")
+			return false
+		}
+	}
+	return true
+}
+
+// amount reads a positive amount in minor units from body, answering a
+// validation error otherwise
+func amount(w http.ResponseWriter, body object) (int64, bool) {
+	n, ok := integer(body, "amount")
+	if !ok || n <= 0 {
+		writeInvalid(w, "amount", "Invalid amount passed")
+		return 0, false
+	}
+	return n, true
+}
+
+// merge copies the given keys from body onto o when present
+func merge(o, body object, keys ...string) {
+	for _, k := range keys {
+		if v, ok := body[k]; ok {
+			if n, ok := v.(json.Number); ok {
+				if i, err := n.Int64(); err == nil {
+					v = i
+				} else if f, err := n.Float64(); err == nil {
+					v = f
+				}
+			}
+			o[k] = v
+		}
+	}
+}
+
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
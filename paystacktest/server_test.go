@@ -0,0 +1,279 @@
+package paystacktest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	paystack "github.com/rpip/paystack-go"
+	"github.com/rpip/paystack-go/paystacktest"
+)
+
+func newClient(t *testing.T) (*paystack.Client, *paystacktest.Server) {
+	t.Helper()
+	srv := paystacktest.NewServer()
+	t.Cleanup(srv.Close)
+	client, err := paystack.NewClientWithOptions("sk_test_key",
+		paystack.WithBaseURL(srv.URL),
+		paystack.WithRetryPolicy(&paystack.RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, srv
+}
+
+func TestAuthentication(t *testing.T) {
+	_, srv := newClient(t)
+	client, _ := paystack.NewClientWithOptions("pk_test_key", paystack.WithBaseURL(srv.URL))
+	_, err := client.Bank.List()
+	if !errors.Is(err, paystack.ErrAuthentication) {
+		t.Errorf("Expected authentication error, got %v", err)
+	}
+}
+
+func TestTransactionFlow(t *testing.T) {
+	client, srv := newClient(t)
+
+	resp, err := client.Transaction.Initialize(&paystack.TransactionRequest{
+		Email: "ada@example.com", Amount: 500000, Reference: "order-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.AccessCode == "" || resp.AuthorizationURL == "" {
+		t.Errorf("Expected access code and authorization URL, got %v", resp)
+	}
+
+	txn, err := client.Transaction.Verify("order-1")
+	if err != nil || txn.Status != "abandoned" {
+		t.Fatalf("Expected abandoned transaction, got %+v, %v", txn, err)
+	}
+
+	if err := srv.CompleteTransaction("order-1"); err != nil {
+		t.Fatal(err)
+	}
+	txn, _ = client.Transaction.Verify("order-1")
+	if txn.Status != "success" || txn.Authorization.AuthorizationCode == "" {
+		t.Fatalf("Expected paid transaction with authorization, got %+v", txn)
+	}
+
+	// the saved card can be charged again
+	txn2, err := client.Transaction.ChargeAuthorization(&paystack.TransactionRequest{
+		Email: "ada@example.com", Amount: 250000, AuthorizationCode: txn.Authorization.AuthorizationCode,
+	})
+	if err != nil || txn2.Status != "success" {
+		t.Fatalf("Expected successful recurring charge, got %+v, %v", txn2, err)
+	}
+
+	_, err = client.Transaction.Initialize(&paystack.TransactionRequest{
+		Email: "ada@example.com", Amount: 500000, Reference: "order-1",
+	})
+	if !errors.Is(err, paystack.ErrDuplicateReference) {
+		t.Errorf("Expected duplicate reference error, got %v", err)
+	}
+
+	_, err = client.Transaction.Verify("missing")
+	if !errors.Is(err, paystack.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	client, _ := newClient(t)
+	_, err := client.Plan.Create(&paystack.Plan{Name: "Gold", Amount: 100000, Interval: "fortnightly"})
+
+	var verr *paystack.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+	if len(verr.Fields["interval"]) == 0 {
+		t.Errorf("Expected interval field error, got %v", verr.Fields)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	client, _ := newClient(t)
+	for _, email := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+		if _, err := client.Customer.Create(&paystack.Customer{Email: email}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list, err := client.Customer.ListN(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Meta.Total != 3 || list.Meta.PageCount != 2 || len(list.Values) != 1 {
+		t.Errorf("Unexpected page: %+v", list)
+	}
+	// newest first
+	if list.Values[0].Email != "a@example.com" {
+		t.Errorf("Expected oldest customer on last page, got %v", list.Values[0].Email)
+	}
+
+	n := 0
+	for _, err := range client.Customer.ListAll(context.Background(), &paystack.ListOptions{PerPage: 1}).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 3 {
+		t.Errorf("Expected 3 customers, got %d", n)
+	}
+}
+
+func TestTransferOTP(t *testing.T) {
+	client, srv := newClient(t)
+
+	rcp, err := client.Transfer.CreateRecipient(&paystack.TransferRecipient{
+		Type: "nuban", Name: "Test Account", AccountNumber: "0001234560", BankCode: "058", Currency: paystack.NGN,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trf, err := client.Transfer.Initiate(&paystack.TransferRequest{
+		Source: "balance", Amount: 500000, Recipient: rcp.RecipientCode,
+	})
+	if err != nil || trf.Status != "otp" {
+		t.Fatalf("Expected transfer awaiting OTP, got %+v, %v", trf, err)
+	}
+
+	if _, err := client.Transfer.Finalize(trf.TransferCode, "000000"); err == nil {
+		t.Error("Expected wrong OTP to be rejected")
+	}
+	done, err := client.Transfer.Finalize(trf.TransferCode, paystacktest.OTP)
+	if err != nil || done.Transfer.Status != "success" || done.Raw["transfer_code"] != trf.TransferCode {
+		t.Fatalf("Expected finalized transfer, got %+v, %v", done, err)
+	}
+	if got := srv.Balance("NGN"); got != paystacktest.DefaultBalance-500000 {
+		t.Errorf("Expected balance to be debited, got %d", got)
+	}
+
+	srv.SetBalance("NGN", 100)
+	_, err = client.Transfer.Initiate(&paystack.TransferRequest{
+		Source: "balance", Amount: 500000, Recipient: rcp.RecipientCode,
+	})
+	if !errors.Is(err, paystack.ErrInsufficientBalance) {
+		t.Errorf("Expected insufficient balance error, got %v", err)
+	}
+}
+
+func TestSubscriptionNeedsAuthorization(t *testing.T) {
+	client, srv := newClient(t)
+
+	plan, _ := client.Plan.Create(&paystack.Plan{Name: "Gold", Amount: 100000, Interval: "monthly"})
+	cust, _ := client.Customer.Create(&paystack.Customer{Email: "sub@example.com"})
+	req := &paystack.SubscriptionRequest{Customer: cust.CustomerCode, Plan: plan.PlanCode}
+
+	if _, err := client.Subscription.Create(req); err == nil {
+		t.Fatal("Expected subscription without a saved card to fail")
+	}
+
+	client.Transaction.Initialize(&paystack.TransactionRequest{Email: "sub@example.com", Amount: 100000, Reference: "first"})
+	srv.CompleteTransaction("first")
+
+	sub, err := client.Subscription.Create(req)
+	if err != nil || sub.Status != "active" {
+		t.Fatalf("Expected active subscription, got %+v, %v", sub, err)
+	}
+	if _, err := client.Subscription.Disable(sub.SubscriptionCode, sub.EmailToken); err != nil {
+		t.Error(err)
+	}
+	sub, _ = client.Subscription.Get(sub.ID)
+	if sub.Status != "cancelled" {
+		t.Errorf("Expected cancelled subscription, got %v", sub.Status)
+	}
+}
+
+func TestFailNext(t *testing.T) {
+	client, srv := newClient(t)
+	srv.FailNext("GET", "/bank", http.StatusServiceUnavailable, "Service unavailable")
+
+	if _, err := client.Bank.List(); !errors.Is(err, paystack.ErrServer) {
+		t.Errorf("Expected injected server error, got %v", err)
+	}
+	if _, err := client.Bank.List(); err != nil {
+		t.Errorf("Expected failure to apply once, got %v", err)
+	}
+}
+
+func TestResolveAccountNumber(t *testing.T) {
+	client, srv := newClient(t)
+	srv.AddAccount("057", "0123456789", "Jane Doe")
+
+	resp, err := client.Bank.ResolveAccountNumber("0123456789", "057")
+	if err != nil || resp.AccountName != "Jane Doe" {
+		t.Errorf("Expected resolved account, got %v, %v", resp, err)
+	}
+	if _, err := client.Bank.ResolveAccountNumber("0123456780", "057"); err == nil {
+		t.Error("Expected unknown account to fail")
+	}
+}
+
+func TestTokenizeThenBulkCharge(t *testing.T) {
+	client, _ := newClient(t)
+
+	tok, err := client.Charge.Tokenize(&paystack.ChargeRequest{
+		Email: "bulk@example.com",
+		Card:  &paystack.Card{Number: "4084084084084081", CVV: "408", ExpirtyMonth: "12", ExpiryYear: "2030"},
+	})
+	if err != nil || tok.Authorization.AuthorizationCode == "" || tok.Customer.Email != "bulk@example.com" {
+		t.Fatalf("Expected tokenized card, got %+v, %v", tok, err)
+	}
+
+	batch, err := client.BulkCharge.Initiate(&paystack.BulkChargeRequest{Items: []paystack.BulkItem{
+		{Authorization: tok.Authorization.AuthorizationCode, Amount: 10000},
+		{Authorization: "AUTH_unknown", Amount: 10000},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	charges, err := client.BulkCharge.GetBatchCharges(batch.BatchCode)
+	if err != nil || len(charges.Values) != 2 {
+		t.Fatalf("Expected two charges, got %+v, %v", charges, err)
+	}
+	if charges.Values[0].Customer.Email != "bulk@example.com" || charges.Values[1].Status != "failed" {
+		t.Errorf("Unexpected charges %+v", charges.Values)
+	}
+	if _, ok := charges.Raw["data"]; !ok {
+		t.Errorf("Expected the raw payload to be kept, got %v", charges.Raw)
+	}
+}
+
+func TestExportAndReauthorize(t *testing.T) {
+	client, srv := newClient(t)
+
+	client.Transaction.Initialize(&paystack.TransactionRequest{Email: "ada@example.com", Amount: 500000, Reference: "paid"})
+	client.Transaction.Initialize(&paystack.TransactionRequest{Email: "ada@example.com", Amount: 700000, Reference: "unpaid"})
+	if err := srv.CompleteTransaction("paid"); err != nil {
+		t.Fatal(err)
+	}
+
+	export, err := client.Transaction.Export(&paystack.ExportParams{Status: "success", Currency: paystack.NGN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txns, err := client.Transaction.DownloadExport(export.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txns) != 1 || txns[0].Reference != "paid" || txns[0].Amount != 500000 || txns[0].Customer.Email != "ada@example.com" {
+		t.Fatalf("Expected the paid transaction only, got %+v", txns)
+	}
+
+	txn, _ := client.Transaction.Verify("paid")
+	req := paystack.AuthorizationRequest{
+		AuthorizationCode: txn.Authorization.AuthorizationCode, Email: "ada@example.com", Amount: 200000,
+	}
+	check, err := client.Transaction.CheckAuthorization(req)
+	if err != nil || check.Amount != 200000 || check.Currency != paystack.NGN {
+		t.Errorf("Expected authorization to cover the amount, got %+v, %v", check, err)
+	}
+	reauth, err := client.Transaction.ReAuthorize(req)
+	if err != nil || reauth.ReauthorizationURL == "" || reauth.Reference == "" {
+		t.Errorf("Expected reauthorization URL, got %+v, %v", reauth, err)
+	}
+}
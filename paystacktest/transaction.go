@@ -0,0 +1,356 @@
+package paystacktest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// CompleteTransaction marks the initialized transaction with the given
+// reference as paid, as if the customer had completed checkout with a
+// reusable card. The customer is created if needed and gains the card's
+// authorization.
+func (s *Server) CompleteTransaction(reference string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	txn := find(s.transactions, reference, "reference")
+	if txn == nil {
+		return fmt.Errorf("paystacktest: no transaction with reference %q", reference)
+	}
+	s.succeed(txn, s.newAuthorization("408408", "4081", "visa"))
+	return nil
+}
+
+// newTransaction records a transaction for the customer with the given email
+func (s *Server) newTransaction(email string, amount int64, currency, reference string) object {
+	txn := s.newObject()
+	if currency == "" {
+		currency = "NGN"
+	}
+	if reference == "" {
+		reference = fmt.Sprintf("T%012d", txn["id"])
+	}
+	txn["reference"] = reference
+	txn["amount"] = amount
+	txn["currency"] = currency
+	txn["status"] = "abandoned"
+	txn["gateway_response"] = "The transaction was not completed"
+	txn["fees"] = 0
+	txn["customer"] = copyObject(s.customerFor(email))
+	txn["log"] = object{"time_spent": 0, "attempts": 0, "errors": 0, "success": false, "history": []object{}}
+	s.transactions = append(s.transactions, txn)
+	return txn
+}
+
+// succeed marks txn as paid with auth and saves auth on its customer
+func (s *Server) succeed(txn object, auth object) {
+	txn["status"] = "success"
+	txn["gateway_response"] = "Successful"
+	txn["paid_at"] = s.now().UTC().Format("2006-01-02T15:04:05.000Z")
+	txn["fees"] = fee(txn["amount"].(int64))
+	txn["authorization"] = auth
+	txn["log"] = object{
+		"time_spent": 9, "attempts": 1, "errors": 0, "success": true,
+		"history": []object{
+			{"type": "action", "message": "Attempted to pay", "time": 1},
+			{"type": "success", "message": "Successfully paid", "time": 9},
+		},
+	}
+	if cust := find(s.customers, fmt.Sprint(txn["customer"].(object)["id"])); cust != nil {
+		if auth["reusable"] == true {
+			s.saveAuthorization(cust, auth)
+		}
+		txn["customer"] = copyObject(cust)
+	}
+	s.touch(txn)
+}
+
+// fee is Paystack's local card fee: 1.5% plus ₦100 above ₦2,500, capped at ₦2,000
+func fee(amount int64) int64 {
+	f := amount * 15 / 1000
+	if amount >= 250000 {
+		f += 10000
+	}
+	if f > 200000 {
+		f = 200000
+	}
+	return f
+}
+
+func (s *Server) newAuthorization(bin, last4, brand string) object {
+	s.nextID++
+	return object{
+		"authorization_code": code("AUTH", s.nextID),
+		"bin":                bin,
+		"last4":              last4,
+		"exp_month":          "12",
+		"exp_year":           "2030",
+		"channel":            "card",
+		"card_type":          brand,
+		"bank":               "TEST BANK",
+		"country_code":       "NG",
+		"brand":              brand,
+		"reusable":           true,
+		"signature":          code("SIG", s.nextID),
+	}
+}
+
+// saveAuthorization adds auth to the customer's authorizations unless it is already there
+func (s *Server) saveAuthorization(cust, auth object) {
+	auths, _ := cust["authorizations"].([]object)
+	for _, a := range auths {
+		if a["authorization_code"] == auth["authorization_code"] {
+			return
+		}
+	}
+	cust["authorizations"] = append(auths, auth)
+}
+
+// findAuthorization returns the customer and authorization for code
+func (s *Server) findAuthorization(authCode string) (object, object) {
+	for _, cust := range s.customers {
+		auths, _ := cust["authorizations"].([]object)
+		for _, a := range auths {
+			if a["authorization_code"] == authCode {
+				return cust, a
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) duplicateReference(ref string) bool {
+	return ref != "" && find(s.transactions, ref, "reference") != nil
+}
+
+func (s *Server) initializeTransaction(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "email") {
+		return
+	}
+	amt, ok := amount(w, r.body)
+	if !ok {
+		return
+	}
+	ref := str(r.body, "reference")
+	if s.duplicateReference(ref) {
+		writeError(w, http.StatusBadRequest, "Duplicate Transaction Reference")
+		return
+	}
+	txn := s.newTransaction(str(r.body, "email"), amt, str(r.body, "currency"), ref)
+	txn["access_code"] = code("AC", txn["id"])
+	writeData(w, http.StatusOK, "Authorization URL created", object{
+		"authorization_url": "https://checkout.paystack.com/" + txn["access_code"].(string),
+		"access_code":       txn["access_code"],
+		"reference":         txn["reference"],
+	})
+}
+
+func (s *Server) verifyTransaction(w http.ResponseWriter, r *request, ref string) {
+	txn := find(s.transactions, ref, "reference")
+	if txn == nil {
+		writeError(w, http.StatusNotFound, "Transaction reference not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Verification successful", txn)
+}
+
+func (s *Server) getTransaction(w http.ResponseWriter, r *request, id string) {
+	txn := find(s.transactions, id)
+	if txn == nil {
+		writeError(w, http.StatusNotFound, "Transaction not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Transaction retrieved", txn)
+}
+
+func (s *Server) listTransactions(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	txns := filter(newest(s.transactions), func(o object) bool {
+		if v := q.Get("status"); v != "" && o["status"] != v {
+			return false
+		}
+		if v := q.Get("customer"); v != "" && fmt.Sprint(o["customer"].(object)["id"]) != v {
+			return false
+		}
+		if v := q.Get("amount"); v != "" && fmt.Sprint(o["amount"]) != v {
+			return false
+		}
+		return inRange(r, o)
+	})
+	writeList(w, r, "Transactions retrieved", txns)
+}
+
+func (s *Server) chargeAuthorization(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "authorization_code", "email") {
+		return
+	}
+	amt, ok := amount(w, r.body)
+	if !ok {
+		return
+	}
+	ref := str(r.body, "reference")
+	if s.duplicateReference(ref) {
+		writeError(w, http.StatusBadRequest, "Duplicate Transaction Reference")
+		return
+	}
+	cust, auth := s.findAuthorization(str(r.body, "authorization_code"))
+	if auth == nil || cust["email"] != str(r.body, "email") {
+		writeError(w, http.StatusBadRequest, "Invalid authorization code")
+		return
+	}
+	txn := s.newTransaction(cust["email"].(string), amt, str(r.body, "currency"), ref)
+	s.succeed(txn, auth)
+	writeData(w, http.StatusOK, "Charge attempted", txn)
+}
+
+func (s *Server) transactionTimeline(w http.ResponseWriter, r *request, idOrRef string) {
+	txn := find(s.transactions, idOrRef, "reference")
+	if txn == nil {
+		writeError(w, http.StatusNotFound, "Transaction not found")
+		return
+	}
+	writeData(w, http.StatusOK, "Timeline retrieved", txn["log"])
+}
+
+func (s *Server) transactionTotals(w http.ResponseWriter, r *request) {
+	volume := map[string]int64{}
+	customers := map[interface{}]bool{}
+	count := 0
+	for _, txn := range s.transactions {
+		if !inRange(r, txn) {
+			continue
+		}
+		count++
+		customers[txn["customer"].(object)["id"]] = true
+		if txn["status"] == "success" {
+			volume[txn["currency"].(string)] += txn["amount"].(int64)
+		}
+	}
+	var total int64
+	byCurrency := []object{}
+	for _, cur := range sortedKeys(volume) {
+		total += volume[cur]
+		byCurrency = append(byCurrency, object{"currency": cur, "amount": volume[cur]})
+	}
+	pending := []object{}
+	for _, cur := range sortedKeys(s.balances) {
+		pending = append(pending, object{"currency": cur, "amount": 0})
+	}
+	writeData(w, http.StatusOK, "Transaction totals", object{
+		"total_transactions":            count,
+		"unique_customers":              len(customers),
+		"total_volume":                  total,
+		"total_volume_by_currency":      byCurrency,
+		"pending_transfers":             0,
+		"pending_transfers_by_currency": pending,
+	})
+}
+
+func (s *Server) exportTransactions(w http.ResponseWriter, r *request) {
+	path := "/exports/transactions.csv"
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+	writeData(w, http.StatusOK, "Export successful", object{"path": "http://" + r.Host + path})
+}
+
+// serveExport writes the transactions, or disputes, matching the export query
+// as CSV. Like the signed download links Paystack hands out, it needs no key.
+func (s *Server) serveExport(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req := &request{Request: r}
+	if r.URL.Path == "/exports/disputes.csv" {
+		s.serveDisputeExport(w, req)
+		return
+	}
+	q := r.URL.Query()
+	w.Header().Set("Content-Type", "text/csv")
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"id", "reference", "amount", "currency", "status", "channel", "customer_email", "created_at", "paid_at", "fees"})
+	for _, txn := range s.transactions {
+		if v := q.Get("status"); v != "" && txn["status"] != v {
+			continue
+		}
+		if v := q.Get("currency"); v != "" && txn["currency"] != v {
+			continue
+		}
+		cust := txn["customer"].(object)
+		if v := q.Get("customer"); v != "" && fmt.Sprint(cust["id"]) != v {
+			continue
+		}
+		if !inRange(req, txn) {
+			continue
+		}
+		channel := ""
+		if auth, ok := txn["authorization"].(object); ok {
+			channel = fmt.Sprint(auth["channel"])
+		}
+		paidAt, _ := txn["paid_at"].(string)
+		cw.Write([]string{
+			fmt.Sprint(txn["id"]),
+			fmt.Sprint(txn["reference"]),
+			major(txn["amount"]),
+			fmt.Sprint(txn["currency"]),
+			fmt.Sprint(txn["status"]),
+			channel,
+			fmt.Sprint(cust["email"]),
+			fmt.Sprint(txn["createdAt"]),
+			paidAt,
+			major(txn["fees"]),
+		})
+	}
+	cw.Flush()
+}
+
+// major formats an amount in minor units as the decimal major units used in
+// export files
+func major(v interface{}) string {
+	n, _ := v.(int64)
+	sign := ""
+	if n < 0 {
+		sign, n = "-", -n
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, n/100, n%100)
+}
+
+func (s *Server) requestReauthorization(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "authorization_code") {
+		return
+	}
+	if _, auth := s.findAuthorization(str(r.body, "authorization_code")); auth == nil {
+		writeError(w, http.StatusBadRequest, "Invalid authorization code")
+		return
+	}
+	s.nextID++
+	ref := code("REAUTH", s.nextID)
+	writeData(w, http.StatusOK, "Reauthorization initiated", object{
+		"reauthorization_url": "https://checkout.paystack.com/reauthorize/" + strings.ToLower(ref),
+		"reference":           ref,
+	})
+}
+
+func (s *Server) checkAuthorization(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "authorization_code", "email") {
+		return
+	}
+	amt, ok := amount(w, r.body)
+	if !ok {
+		return
+	}
+	cust, auth := s.findAuthorization(str(r.body, "authorization_code"))
+	if auth == nil || cust["email"] != str(r.body, "email") {
+		writeError(w, http.StatusBadRequest, "Invalid authorization code")
+		return
+	}
+	currency := str(r.body, "currency")
+	if currency == "" {
+		currency = "NGN"
+	}
+	writeData(w, http.StatusOK, "Authorization is valid for this amount", object{
+		"amount":   amt,
+		"currency": currency,
+	})
+}
@@ -0,0 +1,245 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) createRecipient(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "type", "name") {
+		return
+	}
+	typ := str(r.body, "type")
+	details := object{}
+	switch typ {
+	case "nuban", "Nuban", "ghipss", "mobile_money", "basa":
+		if !require(w, r.body, "account_number", "bank_code") {
+			return
+		}
+		bank := find(s.banks, str(r.body, "bank_code"), "code")
+		if bank == nil {
+			writeInvalid(w, "bank_code", "Bank code is invalid")
+			return
+		}
+		details = object{
+			"account_number": str(r.body, "account_number"),
+			"account_name":   s.accounts[str(r.body, "bank_code")+"/"+str(r.body, "account_number")],
+			"bank_code":      bank["code"],
+			"bank_name":      bank["name"],
+		}
+	case "authorization":
+		if !require(w, r.body, "authorization_code") {
+			return
+		}
+		if _, auth := s.findAuthorization(str(r.body, "authorization_code")); auth == nil {
+			writeError(w, http.StatusBadRequest, "Invalid authorization code")
+			return
+		}
+	default:
+		writeInvalid(w, "type", fmt.Sprintf("Recipient type %q is invalid", typ))
+		return
+	}
+
+	rcp := s.newObject()
+	rcp["recipient_code"] = code("RCP", rcp["id"])
+	rcp["type"] = typ
+	rcp["currency"] = "NGN"
+	rcp["active"] = true
+	rcp["details"] = details
+	merge(rcp, r.body, "name", "description", "currency", "metadata")
+	s.recipients = append(s.recipients, rcp)
+	writeData(w, http.StatusCreated, "Transfer recipient created successfully", rcp)
+}
+
+func (s *Server) listRecipients(w http.ResponseWriter, r *request) {
+	writeList(w, r, "Recipients retrieved", newest(s.recipients))
+}
+
+// newTransfer validates and records a transfer from body, returning an
+// error message when it cannot be made
+func (s *Server) newTransfer(body object) (object, int, string) {
+	if str(body, "recipient") == "" {
+		return nil, http.StatusBadRequest, "Recipient is required"
+	}
+	amt, ok := integer(body, "amount")
+	if !ok || amt <= 0 {
+		return nil, http.StatusBadRequest, "Invalid amount passed"
+	}
+	rcp := find(s.recipients, str(body, "recipient"), "recipient_code")
+	if rcp == nil {
+		return nil, http.StatusBadRequest, "Invalid transfer recipient"
+	}
+	ref := str(body, "reference")
+	if ref != "" && find(s.transfers, ref, "reference") != nil {
+		return nil, http.StatusBadRequest, "Duplicate Transfer Reference"
+	}
+	currency := str(body, "currency")
+	if currency == "" {
+		currency = fmt.Sprint(rcp["currency"])
+	}
+	if s.balances[currency] < amt {
+		return nil, http.StatusBadRequest, "Your balance is not enough to fulfil this request"
+	}
+
+	trf := s.newObject()
+	if ref == "" {
+		ref = fmt.Sprintf("TRF%012d", trf["id"])
+	}
+	trf["transfer_code"] = code("TRF", trf["id"])
+	trf["reference"] = ref
+	trf["source"] = "balance"
+	trf["amount"] = amt
+	trf["currency"] = currency
+	trf["reason"] = str(body, "reason")
+	trf["recipient"] = rcp["id"]
+	trf["failures"] = nil
+	if s.transferOTP {
+		trf["status"] = "otp"
+	} else {
+		s.completeTransfer(trf)
+	}
+	s.transfers = append(s.transfers, trf)
+	return trf, 0, ""
+}
+
+// completeTransfer debits the balance and marks trf as successful
+func (s *Server) completeTransfer(trf object) {
+	s.balances[trf["currency"].(string)] -= trf["amount"].(int64)
+	trf["status"] = "success"
+	trf["transferred_at"] = s.now().UTC().Format("2006-01-02T15:04:05.000Z")
+	s.touch(trf)
+}
+
+func (s *Server) initiateTransfer(w http.ResponseWriter, r *request) {
+	trf, status, msg := s.newTransfer(r.body)
+	if trf == nil {
+		writeError(w, status, msg)
+		return
+	}
+	message := "Transfer has been queued"
+	if trf["status"] == "otp" {
+		message = "Transfer requires OTP to continue"
+	}
+	writeData(w, http.StatusOK, message, trf)
+}
+
+func (s *Server) finalizeTransfer(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "transfer_code", "otp") {
+		return
+	}
+	trf := find(s.transfers, str(r.body, "transfer_code"), "transfer_code")
+	if trf == nil {
+		writeError(w, http.StatusNotFound, "Transfer not found")
+		return
+	}
+	if trf["status"] != "otp" {
+		writeError(w, http.StatusBadRequest, "Transfer is not currently awaiting OTP")
+		return
+	}
+	if str(r.body, "otp") != OTP {
+		writeError(w, http.StatusBadRequest, "Invalid OTP")
+		return
+	}
+	if s.balances[trf["currency"].(string)] < trf["amount"].(int64) {
+		trf["status"] = "failed"
+		writeError(w, http.StatusBadRequest, "Your balance is not enough to fulfil this request")
+		return
+	}
+	s.completeTransfer(trf)
+	writeData(w, http.StatusOK, "Transfer has been queued", trf)
+}
+
+func (s *Server) bulkTransfer(w http.ResponseWriter, r *request) {
+	if s.transferOTP {
+		writeError(w, http.StatusBadRequest, "You need to disable the Transfers OTP requirement to use this endpoint")
+		return
+	}
+	items, _ := r.body["transfers"].([]interface{})
+	if len(items) == 0 {
+		writeInvalid(w, "transfers", "Transfers is required")
+		return
+	}
+	out := []object{}
+	for _, item := range items {
+		body, _ := item.(map[string]interface{})
+		if body == nil {
+			writeInvalid(w, "transfers", "Transfers is invalid")
+			return
+		}
+		if _, ok := body["currency"]; !ok && str(r.body, "currency") != "" {
+			body["currency"] = str(r.body, "currency")
+		}
+		trf, status, msg := s.newTransfer(body)
+		if trf == nil {
+			writeError(w, status, msg)
+			return
+		}
+		out = append(out, object{
+			"recipient":     str(body, "recipient"),
+			"amount":        trf["amount"],
+			"transfer_code": trf["transfer_code"],
+			"currency":      trf["currency"],
+		})
+	}
+	writeData(w, http.StatusOK, fmt.Sprintf("%d transfers queued.", len(out)), out)
+}
+
+func (s *Server) getTransfer(w http.ResponseWriter, r *request, idOrCode string) {
+	trf := find(s.transfers, idOrCode, "transfer_code")
+	if trf == nil {
+		writeError(w, http.StatusNotFound, "Transfer not found")
+		return
+	}
+	out := copyObject(trf)
+	if rcp := find(s.recipients, fmt.Sprint(trf["recipient"])); rcp != nil {
+		out["recipient"] = rcp
+	}
+	writeData(w, http.StatusOK, "Transfer retrieved", out)
+}
+
+func (s *Server) listTransfers(w http.ResponseWriter, r *request) {
+	q := r.URL.Query()
+	trfs := filter(newest(s.transfers), func(o object) bool {
+		if v := q.Get("status"); v != "" && o["status"] != v {
+			return false
+		}
+		if v := q.Get("customer"); v != "" && fmt.Sprint(o["recipient"]) != v {
+			return false
+		}
+		return inRange(r, o)
+	})
+	writeList(w, r, "Transfers retrieved", trfs)
+}
+
+func (s *Server) resendOTP(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "transfer_code", "reason") {
+		return
+	}
+	trf := find(s.transfers, str(r.body, "transfer_code"), "transfer_code")
+	if trf == nil || trf["status"] != "otp" {
+		writeError(w, http.StatusBadRequest, "Transfer is not currently awaiting OTP")
+		return
+	}
+	writeMessage(w, "OTP has been resent")
+}
+
+func (s *Server) enableOTP(w http.ResponseWriter, r *request) {
+	s.transferOTP = true
+	writeMessage(w, "OTP requirement for transfers has been enabled")
+}
+
+func (s *Server) disableOTP(w http.ResponseWriter, r *request) {
+	writeMessage(w, "OTP has been sent to mobile number ending with 4321")
+}
+
+func (s *Server) finalizeDisableOTP(w http.ResponseWriter, r *request) {
+	if !require(w, r.body, "otp") {
+		return
+	}
+	if str(r.body, "otp") != OTP {
+		writeError(w, http.StatusBadRequest, "Invalid OTP")
+		return
+	}
+	s.transferOTP = false
+	writeMessage(w, "OTP requirement for transfers has been disabled")
+}
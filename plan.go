@@ -1,6 +1,9 @@
 package paystack
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // PlanService handles operations related to the plan
 // For more details see https://developers.paystack.co/v1.0/reference#create-plan
@@ -9,23 +12,23 @@ type PlanService service
 // Plan represents a
 // For more details see https://developers.paystack.co/v1.0/reference#create-plan
 type Plan struct {
-	ID                int     `json:"id,omitempty"`
-	CreatedAt         string  `json:"createdAt,omitempty"`
-	UpdatedAt         string  `json:"updatedAt,omitempty"`
-	Domain            string  `json:"domain,omitempty"`
-	Integration       int     `json:"integration,omitempty"`
-	Name              string  `json:"name,omitempty"`
-	Description       string  `json:"description,omitempty"`
-	PlanCode          string  `json:"plan_code,omitempty"`
-	Amount            float32 `json:"amount,omitempty"`
-	Interval          string  `json:"interval,omitempty"`
-	SendInvoices      bool    `json:"send_invoices,omitempty"`
-	SendSMS           bool    `json:"send_sms,omitempty"`
-	Currency          string  `json:"currency,omitempty"`
-	InvoiceLimit      float32 `json:"invoice_limit,omitempty"`
-	HostedPage        string  `json:"hosted_page,omitempty"`
-	HostedPageURL     string  `json:"hosted_page_url,omitempty"`
-	HostedPageSummary string  `json:"hosted_page_summary,omitempty"`
+	ID                int      `json:"id,omitempty"`
+	CreatedAt         string   `json:"createdAt,omitempty"`
+	UpdatedAt         string   `json:"updatedAt,omitempty"`
+	Domain            string   `json:"domain,omitempty"`
+	Integration       int      `json:"integration,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	Description       string   `json:"description,omitempty"`
+	PlanCode          string   `json:"plan_code,omitempty"`
+	Amount            Amount   `json:"amount,omitempty"`
+	Interval          string   `json:"interval,omitempty"`
+	SendInvoices      bool     `json:"send_invoices,omitempty"`
+	SendSMS           bool     `json:"send_sms,omitempty"`
+	Currency          Currency `json:"currency,omitempty"`
+	InvoiceLimit      float32  `json:"invoice_limit,omitempty"`
+	HostedPage        string   `json:"hosted_page,omitempty"`
+	HostedPageURL     string   `json:"hosted_page_url,omitempty"`
+	HostedPageSummary string   `json:"hosted_page_summary,omitempty"`
 }
 
 // PlanList is a list object for Plans.
@@ -37,41 +40,82 @@ type PlanList struct {
 // Create creates a new plan
 // For more details see https://developers.paystack.co/v1.0/reference#create-plan
 func (s *PlanService) Create(plan *Plan) (*Plan, error) {
+	return s.CreateWithContext(context.Background(), plan)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *PlanService) CreateWithContext(ctx context.Context, plan *Plan) (*Plan, error) {
+	if plan == nil {
+		return nil, newRequestValidationError("request", "plan is required")
+	}
 	u := fmt.Sprintf("/plan")
+	req := *plan
+	req.Currency = s.client.currency(req.Currency)
+	if err := checkCurrency(req.Currency, req.Amount, CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
 	plan2 := &Plan{}
-	err := s.client.Call("POST", u, plan, plan2)
+	err := s.client.call(ctx, "Plan.Create", "POST", u, &req, plan2)
 	return plan2, err
 }
 
 // Update updates a plan's properties.
 // For more details see https://developers.paystack.co/v1.0/reference#update-plan
 func (s *PlanService) Update(plan *Plan) (Response, error) {
+	return s.UpdateWithContext(context.Background(), plan)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the request
+func (s *PlanService) UpdateWithContext(ctx context.Context, plan *Plan) (Response, error) {
 	u := fmt.Sprintf("plan/%d", plan.ID)
 	resp := Response{}
-	err := s.client.Call("PUT", u, plan, &resp)
+	err := s.client.call(ctx, "Plan.Update", "PUT", u, plan, &resp)
 	return resp, err
 }
 
 // Get returns the details of a plan.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-plan
 func (s *PlanService) Get(id int) (*Plan, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *PlanService) GetWithContext(ctx context.Context, id int) (*Plan, error) {
 	u := fmt.Sprintf("/plan/%d", id)
 	plan2 := &Plan{}
-	err := s.client.Call("GET", u, nil, plan2)
+	err := s.client.call(ctx, "Plan.Get", "GET", u, nil, plan2)
 	return plan2, err
 }
 
 // List returns a list of plans.
 // For more details see https://developers.paystack.co/v1.0/reference#list-plans
 func (s *PlanService) List() (*PlanList, error) {
-	return s.ListN(10, 0)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *PlanService) ListWithContext(ctx context.Context) (*PlanList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of plans
 // For more details see https://developers.paystack.co/v1.0/reference#list-plans
 func (s *PlanService) ListN(count, offset int) (*PlanList, error) {
-	u := paginateURL("/plan", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *PlanService) ListNWithContext(ctx context.Context, count, offset int) (*PlanList, error) {
+	u := paginateURL("/plan", count, offset, nil)
 	plan2 := &PlanList{}
-	err := s.client.Call("GET", u, nil, plan2)
+	err := s.client.call(ctx, "Plan.ListN", "GET", u, nil, plan2)
 	return plan2, err
 }
+
+// ListAll returns an iterator over all plans, fetching pages as needed
+func (s *PlanService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Plan] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Plan, ListMeta, error) {
+		list, err := s.ListNWithContext(ctx, count, page)
+		return list.Values, list.Meta, err
+	})
+}
@@ -1,6 +1,9 @@
 package paystack
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestPlanCRUD(t *testing.T) {
 	plan1 := &Plan{
@@ -35,3 +38,9 @@ func TestPlanCRUD(t *testing.T) {
 		t.Errorf("Expected Plan list, got %d, returned error %v", len(plans.Values), err)
 	}
 }
+
+func TestPlanCreateNilRequest(t *testing.T) {
+	if _, err := c.Plan.Create(nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil plan to fail validation, got %v", err)
+	}
+}
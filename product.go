@@ -0,0 +1,200 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ProductService handles operations related to products, the goods sold
+// through payment pages
+// For more details see https://developers.paystack.co/v1.0/reference#create-product
+type ProductService service
+
+// Product represents an item in the product catalogue
+// For more details see https://developers.paystack.co/v1.0/reference#create-product
+type Product struct {
+	ID          int      `json:"id,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"`
+	Domain      string   `json:"domain,omitempty"`
+	Integration int      `json:"integration,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	ProductCode string   `json:"product_code,omitempty"`
+	Slug        string   `json:"slug,omitempty"`
+	Price       Amount   `json:"price,omitempty"`
+	Currency    Currency `json:"currency,omitempty"`
+	// Quantity is the number of units in stock. It is ignored when
+	// Unlimited is set.
+	Quantity     int `json:"quantity,omitempty"`
+	QuantitySold int `json:"quantity_sold,omitempty"`
+	// Unlimited is set for products that never run out of stock
+	Unlimited bool     `json:"unlimited,omitempty"`
+	InStock   bool     `json:"in_stock,omitempty"`
+	Active    bool     `json:"active,omitempty"`
+	Type      string   `json:"type,omitempty"`
+	Metadata  Metadata `json:"metadata,omitempty"`
+}
+
+// ProductRequest represents a request to create or update a product. Only
+// the fields that are set are sent, so an update leaves the others as they are.
+type ProductRequest struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Price       Amount   `json:"price,omitempty"`
+	Currency    Currency `json:"currency,omitempty"`
+	// Quantity is the number of units in stock; point it at 0 to mark the
+	// product as sold out. It is ignored when Unlimited is true.
+	Quantity *int `json:"quantity,omitempty"`
+	// Unlimited is true for products that never run out of stock
+	Unlimited *bool    `json:"unlimited,omitempty"`
+	Active    *bool    `json:"active,omitempty"`
+	Metadata  Metadata `json:"metadata,omitempty"`
+}
+
+// ProductList is a list object for products.
+type ProductList struct {
+	Meta   ListMeta
+	Values []Product `json:"data,omitempty"`
+}
+
+// ProductListParams filters the products returned by ListNWithParams and ListAllWithParams
+type ProductListParams struct {
+	From time.Time
+	To   time.Time
+}
+
+func (p *ProductListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	setTime(q, "from", p.From)
+	setTime(q, "to", p.To)
+	return q
+}
+
+// checkStock validates the stock of a product before it is sent
+func checkStock(req *ProductRequest) error {
+	if req.Quantity != nil && *req.Quantity < 0 {
+		return newRequestValidationError("quantity", "quantity cannot be negative")
+	}
+	return nil
+}
+
+// Create creates a new product
+// For more details see https://developers.paystack.co/v1.0/reference#create-product
+func (s *ProductService) Create(req *ProductRequest) (*Product, error) {
+	return s.CreateWithContext(context.Background(), req)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *ProductService) CreateWithContext(ctx context.Context, req *ProductRequest) (*Product, error) {
+	if req == nil {
+		return nil, newRequestValidationError("request", "product is required")
+	}
+	if req.Name == "" {
+		return nil, newRequestValidationError("name", "name is required")
+	}
+	if err := checkStock(req); err != nil {
+		return nil, err
+	}
+	body := *req
+	body.Currency = s.client.currency(body.Currency)
+	if err := checkCurrency(body.Currency, body.Price, CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
+	prod := &Product{}
+	err := s.client.call(ctx, "Product.Create", "POST", "/product", &body, prod)
+	return prod, err
+}
+
+// Update updates the fields of a product that are set in req.
+// For more details see https://developers.paystack.co/v1.0/reference#update-product
+func (s *ProductService) Update(id int, req *ProductRequest) (*Product, error) {
+	return s.UpdateWithContext(context.Background(), id, req)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the request.
+// As with PaymentRequest.Update, an empty currency is left out rather than
+// set to the client's default, and the price is only checked against a
+// currency that is given.
+func (s *ProductService) UpdateWithContext(ctx context.Context, id int, req *ProductRequest) (*Product, error) {
+	if req == nil {
+		return nil, newRequestValidationError("request", "product is required")
+	}
+	if err := checkStock(req); err != nil {
+		return nil, err
+	}
+	if err := checkCurrency(req.Currency, req.Price, CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("/product/%d", id)
+	prod := &Product{}
+	err := s.client.call(ctx, "Product.Update", "PUT", u, req, prod)
+	return prod, err
+}
+
+// Get returns the details of a product.
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-product
+func (s *ProductService) Get(id int) (*Product, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *ProductService) GetWithContext(ctx context.Context, id int) (*Product, error) {
+	u := fmt.Sprintf("/product/%d", id)
+	prod := &Product{}
+	err := s.client.call(ctx, "Product.Get", "GET", u, nil, prod)
+	return prod, err
+}
+
+// List returns a list of products.
+// For more details see https://developers.paystack.co/v1.0/reference#list-products
+func (s *ProductService) List() (*ProductList, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *ProductService) ListWithContext(ctx context.Context) (*ProductList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
+}
+
+// ListN returns a list of products
+// For more details see https://developers.paystack.co/v1.0/reference#list-products
+func (s *ProductService) ListN(count, offset int) (*ProductList, error) {
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *ProductService) ListNWithContext(ctx context.Context, count, offset int) (*ProductList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of products matching params
+func (s *ProductService) ListNWithParams(count, offset int, params *ProductListParams) (*ProductList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *ProductService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *ProductListParams) (*ProductList, error) {
+	u := paginateURL("/product", count, offset, params.values())
+	products := &ProductList{}
+	err := s.client.call(ctx, "Product.ListN", "GET", u, nil, products)
+	return products, err
+}
+
+// ListAll returns an iterator over all products, fetching pages as needed
+func (s *ProductService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Product] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all products matching params
+func (s *ProductService) ListAllWithParams(ctx context.Context, params *ProductListParams, opts *ListOptions) *Iterator[Product] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Product, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
@@ -0,0 +1,127 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rpip/paystack-go/paystacktest"
+)
+
+func TestProductPartialUpdate(t *testing.T) {
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = nil
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{"status":true,"message":"Product successfully updated","data":{"id":1}}`))
+	}))
+	defer ts.Close()
+	client, err := NewClientWithOptions("sk_test_key", WithBaseURL(ts.URL), WithDefaultCurrency(GHS))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Product.Update(1, &ProductRequest{Price: 750000}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sent["currency"]; ok || sent["price"] != float64(750000) {
+		t.Errorf("Expected the price without a currency, got %v", sent)
+	}
+
+	sent = nil
+	if _, err := client.Product.Update(1, &ProductRequest{Price: 100, Currency: NGN}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a price below the minimum to fail validation, got %v", err)
+	}
+	if sent != nil {
+		t.Errorf("Expected nothing to be sent, got %v", sent)
+	}
+	if _, err := client.Product.Update(1, nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil request to fail validation, got %v", err)
+	}
+	if _, err := client.Product.Create(nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil request to fail validation, got %v", err)
+	}
+}
+
+func TestProducts(t *testing.T) {
+	srv := paystacktest.NewServer()
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	if _, err := client.Product.Create(&ProductRequest{Price: 500000}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected missing name to fail validation, got %v", err)
+	}
+	negative := -1
+	if _, err := client.Product.Create(&ProductRequest{Name: "Tote bag", Price: 500000, Quantity: &negative}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected negative quantity to fail validation, got %v", err)
+	}
+
+	stock, unlimited, limited := 20, true, false
+	tote, err := client.Product.Create(&ProductRequest{Name: "Tote bag", Description: "Canvas", Price: 500000, Quantity: &stock})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tote.ProductCode == "" || tote.Price != 500000 || tote.Currency != NGN || tote.Quantity != 20 || tote.Unlimited || !tote.InStock {
+		t.Errorf("Unexpected product %+v", tote)
+	}
+	ebook, err := client.Product.Create(&ProductRequest{Name: "E-book", Price: 150000, Unlimited: &unlimited})
+	if err != nil || !ebook.Unlimited || !ebook.InStock {
+		t.Fatalf("Expected product with unlimited stock, got %+v, %v", ebook, err)
+	}
+
+	// limited stock needs unlimited to be sent as false
+	stock = 5
+	if ebook, err = client.Product.Update(ebook.ID, &ProductRequest{Unlimited: &limited, Quantity: &stock}); err != nil || ebook.Unlimited || ebook.Quantity != 5 {
+		t.Errorf("Expected limited stock, got %+v, %v", ebook, err)
+	}
+	// a partial update leaves the stock alone
+	if ebook, err = client.Product.Update(ebook.ID, &ProductRequest{Description: "PDF"}); err != nil || ebook.Quantity != 5 || ebook.Description != "PDF" {
+		t.Errorf("Expected only the description to change, got %+v, %v", ebook, err)
+	}
+	stock = 0
+	if ebook, err = client.Product.Update(ebook.ID, &ProductRequest{Quantity: &stock}); err != nil || ebook.Quantity != 0 || ebook.InStock {
+		t.Errorf("Expected the product to be sold out, got %+v, %v", ebook, err)
+	}
+	got, err := client.Product.Get(tote.ID)
+	if err != nil || got.Name != "Tote bag" {
+		t.Errorf("Expected to fetch the product, got %+v, %v", got, err)
+	}
+	if _, err := client.Product.Get(9999); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+
+	list, err := client.Product.List()
+	if err != nil || len(list.Values) != 2 || list.Values[0].ID != ebook.ID {
+		t.Errorf("Expected both products, newest first, got %+v, %v", list, err)
+	}
+	var n int
+	for _, err := range client.Product.ListAll(context.Background(), &ListOptions{PerPage: 1}).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 2 {
+		t.Errorf("Expected to iterate over 2 products, got %d", n)
+	}
+
+	page, err := client.Page.Create(&Page{Name: "Merch"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Page.AddProducts(page.ID); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected adding no products to fail validation, got %v", err)
+	}
+	if page, err = client.Page.AddProducts(page.ID, tote.ID, ebook.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Products) != 2 || page.Products[0].ProductCode != tote.ProductCode {
+		t.Errorf("Expected products on the page, got %+v", page.Products)
+	}
+	if _, err := client.Page.AddProducts(page.ID, 9999); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected unknown product to be rejected, got %v", err)
+	}
+}
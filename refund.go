@@ -0,0 +1,169 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// RefundService handles operations related to refunds
+// For more details see https://developers.paystack.co/v1.0/reference#create-refund
+type RefundService service
+
+// Refund statuses
+const (
+	RefundPending    = "pending"
+	RefundProcessing = "processing"
+	RefundProcessed  = "processed"
+	RefundFailed     = "failed"
+)
+
+// Refund represents a Paystack refund
+// For more details see https://developers.paystack.co/v1.0/reference#create-refund
+type Refund struct {
+	ID          int    `json:"id,omitempty"`
+	CreatedAt   string `json:"createdAt,omitempty"`
+	UpdatedAt   string `json:"updatedAt,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	Integration int    `json:"integration,omitempty"`
+	// Create returns the transaction object, List and Get its ID
+	Transaction    interface{} `json:"transaction,omitempty"`
+	Dispute        interface{} `json:"dispute,omitempty"`
+	Amount         Amount      `json:"amount,omitempty"`
+	DeductedAmount Amount      `json:"deducted_amount,omitempty"`
+	FullyDeducted  bool        `json:"fully_deducted,omitempty"`
+	Currency       Currency    `json:"currency,omitempty"`
+	Channel        string      `json:"channel,omitempty"`
+	// Status is one of RefundPending, RefundProcessing, RefundProcessed or RefundFailed
+	Status       string `json:"status,omitempty"`
+	RefundedBy   string `json:"refunded_by,omitempty"`
+	RefundedAt   string `json:"refunded_at,omitempty"`
+	ExpectedAt   string `json:"expected_at,omitempty"`
+	CustomerNote string `json:"customer_note,omitempty"`
+	MerchantNote string `json:"merchant_note,omitempty"`
+}
+
+// RefundRequest represents a request to refund a transaction
+type RefundRequest struct {
+	// Transaction is the ID or reference of the transaction to refund
+	Transaction string `json:"transaction"`
+	// Amount to refund, the full transaction amount if zero
+	Amount Amount `json:"amount,omitempty"`
+	// Currency defaults to the currency of the transaction
+	Currency     Currency `json:"currency,omitempty"`
+	CustomerNote string   `json:"customer_note,omitempty"`
+	MerchantNote string   `json:"merchant_note,omitempty"`
+}
+
+// RefundList is a list object for refunds.
+type RefundList struct {
+	Meta   ListMeta
+	Values []Refund `json:"data,omitempty"`
+}
+
+// RefundListParams filters the refunds returned by ListNWithParams and ListAllWithParams
+type RefundListParams struct {
+	// Transaction is the ID or reference of the refunded transaction
+	Transaction string
+	Currency    Currency
+	From        time.Time
+	To          time.Time
+}
+
+func (p *RefundListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Transaction != "" {
+		q.Set("transaction", p.Transaction)
+	}
+	if p.Currency != "" {
+		q.Set("currency", string(p.Currency))
+	}
+	setTime(q, "from", p.From)
+	setTime(q, "to", p.To)
+	return q
+}
+
+// Create refunds a transaction in full, or partially when req.Amount is set
+// For more details see https://developers.paystack.co/v1.0/reference#create-refund
+func (s *RefundService) Create(req *RefundRequest) (*Refund, error) {
+	return s.CreateWithContext(context.Background(), req)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *RefundService) CreateWithContext(ctx context.Context, req *RefundRequest) (*Refund, error) {
+	if req.Transaction == "" {
+		return nil, newRequestValidationError("transaction", "transaction is required")
+	}
+	if err := checkCurrency(req.Currency, 0, nil); err != nil {
+		return nil, err
+	}
+	refund := &Refund{}
+	err := s.client.call(ctx, "Refund.Create", "POST", "/refund", req, refund)
+	return refund, err
+}
+
+// Get returns the details of a refund.
+// For more details see https://developers.paystack.co/v1.0/reference#fetch-refund
+func (s *RefundService) Get(id int) (*Refund, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *RefundService) GetWithContext(ctx context.Context, id int) (*Refund, error) {
+	u := fmt.Sprintf("/refund/%d", id)
+	refund := &Refund{}
+	err := s.client.call(ctx, "Refund.Get", "GET", u, nil, refund)
+	return refund, err
+}
+
+// List returns a list of refunds.
+// For more details see https://developers.paystack.co/v1.0/reference#list-refunds
+func (s *RefundService) List() (*RefundList, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *RefundService) ListWithContext(ctx context.Context) (*RefundList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
+}
+
+// ListN returns a list of refunds
+// For more details see https://developers.paystack.co/v1.0/reference#list-refunds
+func (s *RefundService) ListN(count, offset int) (*RefundList, error) {
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *RefundService) ListNWithContext(ctx context.Context, count, offset int) (*RefundList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of refunds matching params
+func (s *RefundService) ListNWithParams(count, offset int, params *RefundListParams) (*RefundList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *RefundService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *RefundListParams) (*RefundList, error) {
+	u := paginateURL("/refund", count, offset, params.values())
+	refunds := &RefundList{}
+	err := s.client.call(ctx, "Refund.ListN", "GET", u, nil, refunds)
+	return refunds, err
+}
+
+// ListAll returns an iterator over all refunds, fetching pages as needed
+func (s *RefundService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Refund] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all refunds matching params
+func (s *RefundService) ListAllWithParams(ctx context.Context, params *RefundListParams, opts *ListOptions) *Iterator[Refund] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Refund, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
@@ -0,0 +1,84 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rpip/paystack-go/paystacktest"
+)
+
+func TestRefunds(t *testing.T) {
+	srv := paystacktest.NewServer()
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	for _, ref := range []string{"refund-1", "refund-2"} {
+		if _, err := client.Transaction.Initialize(&TransactionRequest{Email: "ada@example.com", Amount: 500000, Reference: ref}); err != nil {
+			t.Fatal(err)
+		}
+		if err := srv.CompleteTransaction(ref); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	partial, err := client.Refund.Create(&RefundRequest{
+		Transaction: "refund-1", Amount: 200000, Currency: NGN,
+		CustomerNote: "Item out of stock", MerchantNote: "Restock in May",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partial.Amount != 200000 || partial.Status != RefundPending || partial.CustomerNote != "Item out of stock" {
+		t.Errorf("Unexpected refund %+v", partial)
+	}
+
+	// without an amount the rest of the transaction is refunded
+	rest, err := client.Refund.Create(&RefundRequest{Transaction: "refund-1"})
+	if err != nil || rest.Amount != 300000 {
+		t.Fatalf("Expected the remaining amount to be refunded, got %+v, %v", rest, err)
+	}
+	if _, err := client.Refund.Create(&RefundRequest{Transaction: "refund-1"}); err == nil {
+		t.Error("Expected a fully refunded transaction to be rejected")
+	}
+	if _, err := client.Refund.Create(&RefundRequest{Transaction: "refund-2", Amount: 900000}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected refund above the transaction amount to fail validation, got %v", err)
+	}
+	if _, err := client.Refund.Create(&RefundRequest{}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected missing transaction to fail validation, got %v", err)
+	}
+	if _, err := client.Refund.Create(&RefundRequest{Transaction: "refund-2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := srv.CompleteRefund(partial.ID); err != nil {
+		t.Fatal(err)
+	}
+	got, err := client.Refund.Get(partial.ID)
+	if err != nil || got.Status != RefundProcessed || got.RefundedAt == "" {
+		t.Errorf("Expected processed refund, got %+v, %v", got, err)
+	}
+	if _, err := client.Refund.Get(9999); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+
+	list, err := client.Refund.ListNWithParams(10, 1, &RefundListParams{Transaction: "refund-1"})
+	if err != nil || len(list.Values) != 2 {
+		t.Fatalf("Expected two refunds for refund-1, got %+v, %v", list, err)
+	}
+	page, err := client.Refund.ListN(1, 2)
+	if err != nil || len(page.Values) != 1 || page.Meta.Total != 3 || page.Meta.Page != 2 {
+		t.Errorf("Expected the second of three pages, got %+v, %v", page, err)
+	}
+
+	var n int
+	for _, err := range client.Refund.ListAll(context.Background(), &ListOptions{PerPage: 2}).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 3 {
+		t.Errorf("Expected to iterate over 3 refunds, got %d", n)
+	}
+}
@@ -0,0 +1,62 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// ResponseInfo describes the HTTP response to an API call, including the
+// parts of the Paystack envelope that are not decoded into the result
+type ResponseInfo struct {
+	StatusCode int
+	// Header holds the response headers, such as the rate limit headers
+	Header http.Header
+	// Message is the message of the Paystack envelope
+	Message string
+	// Meta is the meta object of the envelope, nil if there is none
+	Meta map[string]interface{}
+	// Body is the raw response body
+	Body []byte
+	// Latency is the time taken by the call, retries included
+	Latency time.Duration
+}
+
+// responseInfoCtx is the context key under which the collector is stored
+type responseInfoCtx struct{}
+
+// WithResponseInfo returns a copy of ctx that makes calls made with it fill
+// info with their response. Each call overwrites info, so use a separate
+// context for each call whose response you want to keep:
+//
+//	var info paystack.ResponseInfo
+//	txn, err := client.Transaction.VerifyWithContext(paystack.WithResponseInfo(ctx, &info), ref)
+//	log.Println(info.StatusCode, info.Message, info.Header.Get("X-RateLimit-Remaining"))
+//
+// info is filled for failed calls too, as long as a response was received.
+func WithResponseInfo(ctx context.Context, info *ResponseInfo) context.Context {
+	return context.WithValue(ctx, responseInfoCtx{}, info)
+}
+
+// responseInfo returns the collector stored in ctx, if any
+func responseInfo(ctx context.Context) *ResponseInfo {
+	info, _ := ctx.Value(responseInfoCtx{}).(*ResponseInfo)
+	return info
+}
+
+func newResponseInfo(resp *http.Response, body []byte, latency time.Duration) *ResponseInfo {
+	var envelope struct {
+		Message string                 `json:"message"`
+		Meta    map[string]interface{} `json:"meta"`
+	}
+	json.Unmarshal(body, &envelope)
+	return &ResponseInfo{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Message:    envelope.Message,
+		Meta:       envelope.Meta,
+		Body:       body,
+		Latency:    latency,
+	}
+}
@@ -0,0 +1,44 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithResponseInfo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		if r.URL.Path == "/customer/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":false,"message":"Customer not found"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Customers retrieved","data":[],"meta":{"total":0,"page":1}}`))
+	}))
+	defer ts.Close()
+	client := newTestClient(ts.URL)
+
+	var info ResponseInfo
+	ctx := WithResponseInfo(context.Background(), &info)
+	if _, err := client.Customer.ListWithContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if info.StatusCode != http.StatusOK || info.Message != "Customers retrieved" {
+		t.Errorf("Unexpected response info %+v", info)
+	}
+	if info.Header.Get("X-RateLimit-Remaining") != "99" || info.Meta["page"] != 1.0 {
+		t.Errorf("Expected headers and meta, got %v and %v", info.Header, info.Meta)
+	}
+	if len(info.Body) == 0 || info.Latency <= 0 {
+		t.Errorf("Expected body and latency, got %q and %v", info.Body, info.Latency)
+	}
+
+	// failed calls are described too
+	_, err := client.Customer.GetWithContext(ctx, "missing")
+	if !errors.Is(err, ErrNotFound) || info.StatusCode != http.StatusNotFound || info.Message != "Customer not found" {
+		t.Errorf("Expected not found info, got %+v, %v", info, err)
+	}
+}
@@ -0,0 +1,178 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes when and how often Client.Call retries a failed request.
+// Only idempotent HTTP methods, and requests carrying an idempotency key, are
+// retried unless RetryUnsafe is set, so that a POST that may already have
+// reached Paystack is never sent twice by default.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry. Each further retry
+	// multiplies it by Multiplier, up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	Multiplier float64
+
+	// Jitter is the fraction (0 to 1) of each delay that is randomized,
+	// to avoid many clients retrying in lockstep.
+	Jitter float64
+
+	// RetryableStatus lists the HTTP status codes that trigger a retry
+	RetryableStatus []int
+
+	// RetryableError reports whether a transport error should be retried.
+	// If nil, every error except context cancellation is retried.
+	RetryableError func(err error) bool
+
+	// RetryUnsafe allows retrying non-idempotent methods such as POST
+	RetryUnsafe bool
+}
+
+// DefaultRetryPolicy returns the policy used by NewClient: three attempts with
+// exponential backoff starting at 500ms, retrying 429 and 5xx gateway errors
+// for idempotent requests only.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+		Multiplier:  2,
+		Jitter:      0.2,
+		RetryableStatus: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// allows reports whether a request with the given method may be retried
+func (p *RetryPolicy) allows(method string) bool {
+	if p.RetryUnsafe {
+		return true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether the outcome of an attempt warrants another one
+func (p *RetryPolicy) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		if p.RetryableError != nil {
+			return p.RetryableError(err)
+		}
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	for _, code := range p.RetryableStatus {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (1 for the first retry)
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	mult := p.Multiplier
+	if mult < 1 {
+		mult = 1
+	}
+	d := float64(p.MinBackoff) * math.Pow(mult, float64(retry-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d -= d * p.Jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an HTTP date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// do sends the request, retrying it according to c.RetryPolicy
+func (c *Client) do(ctx context.Context, method, url string, payload []byte, header http.Header) (*http.Response, error) {
+	policy := c.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		req, err := c.newRequest(ctx, method, url, payload, header)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.client.Do(req)
+		if policy == nil || attempt >= policy.MaxAttempts ||
+			!(policy.allows(method) || idempotencyKey(ctx) != "") ||
+			!policy.shouldRetry(ctx, resp, err) {
+			if err != nil && ctx.Err() == nil {
+				err = &NetworkError{Err: err}
+			}
+			return resp, err
+		}
+
+		wait := policy.backoff(attempt)
+		if d, ok := retryAfter(resp); ok {
+			wait = d
+		}
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if c.LoggingEnabled {
+			if err != nil {
+				c.Log.Printf("Attempt %d failed: %v, retrying in %v\n", attempt, err, wait)
+			} else {
+				c.Log.Printf("Attempt %d returned %d, retrying in %v\n", attempt, resp.StatusCode, wait)
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyServer fails the first n requests with the given status before succeeding
+func flakyServer(n int32, status int, hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(hits, 1) <= n {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			w.Write([]byte(`{"status":false,"message":"try again"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"ok","data":{"id":1,"reference":"ref-1"}}`))
+	}))
+}
+
+func fastRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.MinBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	p.Jitter = 0
+	return p
+}
+
+func TestRetrySucceedsAfterFailures(t *testing.T) {
+	var hits int32
+	ts := flakyServer(2, http.StatusServiceUnavailable, &hits)
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	client.RetryPolicy = fastRetryPolicy()
+
+	txn, err := client.Transaction.Verify("ref-1")
+	if err != nil {
+		t.Fatalf("Expected retries to succeed, got %v", err)
+	}
+	if txn.Reference != "ref-1" {
+		t.Errorf("Expected reference ref-1, got %v", txn.Reference)
+	}
+	if atomic.LoadInt32(&hits) != 3 {
+		t.Errorf("Expected 3 attempts, got %d", hits)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var hits int32
+	ts := flakyServer(5, http.StatusTooManyRequests, &hits)
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	client.RetryPolicy = fastRetryPolicy()
+
+	if _, err := client.Transaction.Verify("ref-1"); err == nil {
+		t.Error("Expected error after exhausting retries")
+	}
+	if atomic.LoadInt32(&hits) != 3 {
+		t.Errorf("Expected 3 attempts, got %d", hits)
+	}
+}
+
+func TestRetrySkipsUnsafeMethods(t *testing.T) {
+	var hits int32
+	ts := flakyServer(1, http.StatusBadGateway, &hits)
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	client.RetryPolicy = fastRetryPolicy()
+
+	if _, err := client.Customer.Create(&Customer{Email: "user@example.com"}); err == nil {
+		t.Error("Expected POST to fail without retrying")
+	}
+	if atomic.LoadInt32(&hits) != 1 {
+		t.Errorf("Expected a single attempt, got %d", hits)
+	}
+}
+
+func TestRetryIgnoresClientErrors(t *testing.T) {
+	var hits int32
+	ts := flakyServer(1, http.StatusBadRequest, &hits)
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	client.RetryPolicy = fastRetryPolicy()
+
+	if _, err := client.Transaction.Verify("ref-1"); err == nil {
+		t.Error("Expected 400 to be returned as an error")
+	}
+	if atomic.LoadInt32(&hits) != 1 {
+		t.Errorf("Expected a single attempt, got %d", hits)
+	}
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if d, ok := retryAfter(resp); !ok || d != 3*time.Second {
+		t.Errorf("Expected 3s, got %v", d)
+	}
+
+	p := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 4 * time.Second, Multiplier: 2}
+	for retry, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 5: 4 * time.Second} {
+		if got := p.backoff(retry); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", retry, got, want)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package paystack
 
+import "context"
+
 // SettlementService handles operations related to the settlement
 // For more details see https://developers.paystack.co/v1.0/reference#create-settlement
 type SettlementService service
@@ -13,14 +15,32 @@ type SettlementList struct {
 // List returns a list of settlements.
 // For more details see https://developers.paystack.co/v1.0/reference#settlements
 func (s *SettlementService) List() (*SettlementList, error) {
-	return s.ListN(10, 0)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *SettlementService) ListWithContext(ctx context.Context) (*SettlementList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of settlements
 // For more details see https://developers.paystack.co/v1.0/reference#settlements
 func (s *SettlementService) ListN(count, offset int) (*SettlementList, error) {
-	u := paginateURL("/settlement", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *SettlementService) ListNWithContext(ctx context.Context, count, offset int) (*SettlementList, error) {
+	u := paginateURL("/settlement", count, offset, nil)
 	pg := &SettlementList{}
-	err := s.client.Call("GET", u, nil, pg)
+	err := s.client.call(ctx, "Settlement.ListN", "GET", u, nil, pg)
 	return pg, err
 }
+
+// ListAll returns an iterator over all settlements, fetching pages as needed
+func (s *SettlementService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Response] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Response, ListMeta, error) {
+		list, err := s.ListNWithContext(ctx, count, page)
+		return list.Values, list.Meta, err
+	})
+}
@@ -1,6 +1,9 @@
 package paystack
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // SubAccountService handles operations related to sub accounts
 // For more details see https://developers.paystack.co/v1.0/reference#create-subaccount
@@ -39,9 +42,14 @@ type SubAccountList struct {
 // Create creates a new subaccount
 // For more details see https://paystack.com/docs/api/#subaccount-create
 func (s *SubAccountService) Create(subaccount *SubAccount) (*SubAccount, error) {
+	return s.CreateWithContext(context.Background(), subaccount)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *SubAccountService) CreateWithContext(ctx context.Context, subaccount *SubAccount) (*SubAccount, error) {
 	u := fmt.Sprintf("/subaccount")
 	acc := &SubAccount{}
-	err := s.client.Call("POST", u, subaccount, acc)
+	err := s.client.call(ctx, "SubAccount.Create", "POST", u, subaccount, acc)
 	return acc, err
 }
 
@@ -49,9 +57,14 @@ func (s *SubAccountService) Create(subaccount *SubAccount) (*SubAccount, error)
 // For more details see https://developers.paystack.co/v1.0/reference#update-subaccount
 // TODO: use ID or slug
 func (s *SubAccountService) Update(subaccount *SubAccount) (*SubAccount, error) {
+	return s.UpdateWithContext(context.Background(), subaccount)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the request
+func (s *SubAccountService) UpdateWithContext(ctx context.Context, subaccount *SubAccount) (*SubAccount, error) {
 	u := fmt.Sprintf("subaccount/%d", subaccount.ID)
 	acc := &SubAccount{}
-	err := s.client.Call("PUT", u, subaccount, acc)
+	err := s.client.call(ctx, "SubAccount.Update", "PUT", u, subaccount, acc)
 
 	return acc, err
 }
@@ -60,9 +73,14 @@ func (s *SubAccountService) Update(subaccount *SubAccount) (*SubAccount, error)
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-subaccount
 // TODO: use ID or slug
 func (s *SubAccountService) Get(id int) (*SubAccount, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *SubAccountService) GetWithContext(ctx context.Context, id int) (*SubAccount, error) {
 	u := fmt.Sprintf("/subaccount/%d", id)
 	acc := &SubAccount{}
-	err := s.client.Call("GET", u, nil, acc)
+	err := s.client.call(ctx, "SubAccount.Get", "GET", u, nil, acc)
 
 	return acc, err
 }
@@ -70,14 +88,32 @@ func (s *SubAccountService) Get(id int) (*SubAccount, error) {
 // List returns a list of subaccounts.
 // For more details see https://developers.paystack.co/v1.0/reference#list-subaccounts
 func (s *SubAccountService) List() (*SubAccountList, error) {
-	return s.ListN(10, 1)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *SubAccountService) ListWithContext(ctx context.Context) (*SubAccountList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of subaccounts
 // For more details see https://paystack.com/docs/api/#subaccount-list
 func (s *SubAccountService) ListN(count, offset int) (*SubAccountList, error) {
-	u := paginateURL("/subaccount", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *SubAccountService) ListNWithContext(ctx context.Context, count, offset int) (*SubAccountList, error) {
+	u := paginateURL("/subaccount", count, offset, nil)
 	acc := &SubAccountList{}
-	err := s.client.Call("GET", u, nil, acc)
+	err := s.client.call(ctx, "SubAccount.ListN", "GET", u, nil, acc)
 	return acc, err
 }
+
+// ListAll returns an iterator over all subaccounts, fetching pages as needed
+func (s *SubAccountService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[SubAccount] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]SubAccount, ListMeta, error) {
+		list, err := s.ListNWithContext(ctx, count, page)
+		return list.Values, list.Meta, err
+	})
+}
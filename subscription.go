@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -26,7 +27,7 @@ type Subscription struct {
 	Invoices         []interface{} `json:"invoices,omitempty"`
 	Status           string        `json:"status,omitempty"`
 	Quantity         int           `json:"quantity,omitempty"`
-	Amount           int           `json:"amount,omitempty"`
+	Amount           Amount        `json:"amount,omitempty"`
 	SubscriptionCode string        `json:"subscription_code,omitempty"`
 	EmailToken       string        `json:"email_token,omitempty"`
 	EasyCronID       string        `json:"easy_cron_id,omitempty"`
@@ -51,66 +52,142 @@ type SubscriptionList struct {
 	Values []Subscription `json:"data"`
 }
 
+// SubscriptionListParams filters the subscriptions returned by ListNWithParams and ListAllWithParams
+type SubscriptionListParams struct {
+	// Plan is the ID of the plan subscribed to
+	Plan int
+	// Customer is the ID of the subscribed customer
+	Customer int
+}
+
+func (p *SubscriptionListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	setInt(q, "plan", int64(p.Plan))
+	setInt(q, "customer", int64(p.Customer))
+	return q
+}
+
 // Create creates a new subscription
 // For more details see https://developers.paystack.co/v1.0/reference#create-subscription
 func (s *SubscriptionService) Create(subscription *SubscriptionRequest) (*Subscription, error) {
+	return s.CreateWithContext(context.Background(), subscription)
+}
+
+// CreateWithContext is like Create but carries ctx through to the request
+func (s *SubscriptionService) CreateWithContext(ctx context.Context, subscription *SubscriptionRequest) (*Subscription, error) {
 	u := fmt.Sprintf("/subscription")
 	sub := &Subscription{}
-	err := s.client.Call("POST", u, subscription, sub)
+	err := s.client.call(ctx, "Subscription.Create", "POST", u, subscription, sub)
 	return sub, err
 }
 
 // Update updates a subscription's properties.
 // For more details see https://developers.paystack.co/v1.0/reference#update-subscription
 func (s *SubscriptionService) Update(subscription *Subscription) (*Subscription, error) {
+	return s.UpdateWithContext(context.Background(), subscription)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the request
+func (s *SubscriptionService) UpdateWithContext(ctx context.Context, subscription *Subscription) (*Subscription, error) {
 	u := fmt.Sprintf("subscription/%d", subscription.ID)
 	sub := &Subscription{}
-	err := s.client.Call("PUT", u, subscription, sub)
+	err := s.client.call(ctx, "Subscription.Update", "PUT", u, subscription, sub)
 	return sub, err
 }
 
 // Get returns the details of a subscription.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-subscription
 func (s *SubscriptionService) Get(id int) (*Subscription, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *SubscriptionService) GetWithContext(ctx context.Context, id int) (*Subscription, error) {
 	u := fmt.Sprintf("/subscription/%d", id)
 	sub := &Subscription{}
-	err := s.client.Call("GET", u, nil, sub)
+	err := s.client.call(ctx, "Subscription.Get", "GET", u, nil, sub)
 	return sub, err
 }
 
 // List returns a list of subscriptions.
 // For more details see https://developers.paystack.co/v1.0/reference#list-subscriptions
 func (s *SubscriptionService) List() (*SubscriptionList, error) {
-	return s.ListN(10, 0)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *SubscriptionService) ListWithContext(ctx context.Context) (*SubscriptionList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of subscriptions
 // For more details see https://developers.paystack.co/v1.0/reference#list-subscriptions
 func (s *SubscriptionService) ListN(count, offset int) (*SubscriptionList, error) {
-	u := paginateURL("/subscription", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *SubscriptionService) ListNWithContext(ctx context.Context, count, offset int) (*SubscriptionList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of subscriptions matching params
+func (s *SubscriptionService) ListNWithParams(count, offset int, params *SubscriptionListParams) (*SubscriptionList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *SubscriptionService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *SubscriptionListParams) (*SubscriptionList, error) {
+	u := paginateURL("/subscription", count, offset, params.values())
 	sub := &SubscriptionList{}
-	err := s.client.Call("GET", u, nil, sub)
+	err := s.client.call(ctx, "Subscription.ListN", "GET", u, nil, sub)
 	return sub, err
 }
 
+// ListAll returns an iterator over all subscriptions, fetching pages as needed
+func (s *SubscriptionService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Subscription] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all subscriptions matching params
+func (s *SubscriptionService) ListAllWithParams(ctx context.Context, params *SubscriptionListParams, opts *ListOptions) *Iterator[Subscription] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Subscription, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
+
 // Enable enables a subscription
 // For more details see https://developers.paystack.co/v1.0/reference#enable-subscription
 func (s *SubscriptionService) Enable(subscriptionCode, emailToken string) (Response, error) {
+	return s.EnableWithContext(context.Background(), subscriptionCode, emailToken)
+}
+
+// EnableWithContext is like Enable but carries ctx through to the request
+func (s *SubscriptionService) EnableWithContext(ctx context.Context, subscriptionCode, emailToken string) (Response, error) {
 	params := url.Values{}
 	params.Add("code", subscriptionCode)
 	params.Add("token", emailToken)
 	resp := Response{}
-	err := s.client.Call("POST", "/subscription/enable", params, &resp)
+	err := s.client.call(ctx, "Subscription.Enable", "POST", "/subscription/enable", params, &resp)
 	return resp, err
 }
 
 // Disable disables a subscription
 // For more details see https://developers.paystack.co/v1.0/reference#disable-subscription
 func (s *SubscriptionService) Disable(subscriptionCode, emailToken string) (Response, error) {
+	return s.DisableWithContext(context.Background(), subscriptionCode, emailToken)
+}
+
+// DisableWithContext is like Disable but carries ctx through to the request
+func (s *SubscriptionService) DisableWithContext(ctx context.Context, subscriptionCode, emailToken string) (Response, error) {
 	params := url.Values{}
 	params.Add("code", subscriptionCode)
 	params.Add("token", emailToken)
 	resp := Response{}
-	err := s.client.Call("POST", "/subscription/disable", params, &resp)
+	err := s.client.call(ctx, "Subscription.Disable", "POST", "/subscription/disable", params, &resp)
 	return resp, err
 }
@@ -1,6 +1,10 @@
 package paystack
 
-import "testing"
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
 
 func TestSubscriptionCRUD(t *testing.T) {
 	cust := &Customer{
@@ -44,3 +48,21 @@ func TestSubscriptionCRUD(t *testing.T) {
 		t.Errorf("Expected Subscription list, got %d, returned error %v", len(subscriptions.Values), err)
 	}
 }
+
+func TestSubscriptionListParams(t *testing.T) {
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte(`{"status":true,"message":"ok","data":[],"meta":{"total":0}}`))
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	params := &SubscriptionListParams{Plan: 7, Customer: 9}
+	if _, err := client.Subscription.ListNWithParams(10, 1, params); err != nil {
+		t.Fatal(err)
+	}
+	if query != "customer=9&page=1&perPage=10&plan=7" {
+		t.Errorf("Unexpected query %q", query)
+	}
+}
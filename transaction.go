@@ -1,6 +1,17 @@
 package paystack
 
-import "fmt"
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // TransactionService handles operations related to transactions
 // For more details see https://developers.paystack.co/v1.0/reference#create-transaction
@@ -12,19 +23,82 @@ type TransactionList struct {
 	Values []Transaction `json:"data"`
 }
 
+// TransactionListParams filters the transactions returned by ListNWithParams and ListAllWithParams
+type TransactionListParams struct {
+	// Status is one of "success", "failed" or "abandoned"
+	Status string
+	// Customer is the ID of the customer the transactions belong to
+	Customer int
+	From     time.Time
+	To       time.Time
+	// Amount matches transactions of exactly this amount
+	Amount Amount
+}
+
+func (p *TransactionListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != "" {
+		q.Set("status", p.Status)
+	}
+	setInt(q, "customer", int64(p.Customer))
+	setTime(q, "from", p.From)
+	setTime(q, "to", p.To)
+	setInt(q, "amount", int64(p.Amount))
+	return q
+}
+
+// ExportParams filters the transactions exported by Export
+type ExportParams struct {
+	From time.Time
+	To   time.Time
+	// Status is one of "success", "failed" or "abandoned"
+	Status   string
+	Currency Currency
+	// Settled exports only settled or only unsettled transactions when set
+	Settled *bool
+	// Settlement is the ID of the settlement whose transactions are exported
+	Settlement int
+	// Customer is the ID of the customer the transactions belong to
+	Customer int
+}
+
+func (p *ExportParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	setTime(q, "from", p.From)
+	setTime(q, "to", p.To)
+	if p.Status != "" {
+		q.Set("status", p.Status)
+	}
+	if p.Currency != "" {
+		q.Set("currency", string(p.Currency))
+	}
+	if p.Settled != nil {
+		q.Set("settled", strconv.FormatBool(*p.Settled))
+	}
+	setInt(q, "settlement", int64(p.Settlement))
+	setInt(q, "customer", int64(p.Customer))
+	return q
+}
+
 // TransactionRequest represents a request to start a transaction.
 type TransactionRequest struct {
 	CallbackURL       string   `json:"callback_url,omitempty"`
 	Reference         string   `json:"reference,omitempty"`
 	AuthorizationCode string   `json:"authorization_code,omitempty"`
-	Currency          string   `json:"currency,omitempty"`
-	Amount            float32  `json:"amount,omitempty"`
+	Currency          Currency `json:"currency,omitempty"`
+	Amount            Amount   `json:"amount,omitempty"`
 	Email             string   `json:"email,omitempty"`
 	Plan              string   `json:"plan,omitempty"`
 	InvoiceLimit      int      `json:"invoice_limit,omitempty"`
 	Metadata          Metadata `json:"metadata,omitempty"`
 	SubAccount        string   `json:"subaccount,omitempty"`
-	TransactionCharge int      `json:"transaction_charge,omitempty"`
+	TransactionCharge Amount   `json:"transaction_charge,omitempty"`
 	Bearer            string   `json:"bearer,omitempty"`
 	Channels          []string `json:"channels,omitempty"`
 }
@@ -33,8 +107,8 @@ type TransactionRequest struct {
 type AuthorizationRequest struct {
 	Reference         string   `json:"reference,omitempty"`
 	AuthorizationCode string   `json:"authorization_code,omitempty"`
-	Amount            int      `json:"amount,omitempty"`
-	Currency          string   `json:"currency,omitempty"`
+	Amount            Amount   `json:"amount,omitempty"`
+	Currency          Currency `json:"currency,omitempty"`
 	Email             string   `json:"email,omitempty"`
 	Metadata          Metadata `json:"metadata,omitempty"`
 }
@@ -48,15 +122,15 @@ type Transaction struct {
 	Metadata        string                 `json:"metadata,omitempty"` //TODO: why is transaction metadata a string?
 	Status          string                 `json:"status,omitempty"`
 	Reference       string                 `json:"reference,omitempty"`
-	Amount          float32                `json:"amount,omitempty"`
+	Amount          Amount                 `json:"amount,omitempty"`
 	Message         string                 `json:"message,omitempty"`
 	GatewayResponse string                 `json:"gateway_response,omitempty"`
 	PaidAt          string                 `json:"piad_at,omitempty"`
 	Channel         string                 `json:"channel,omitempty"`
-	Currency        string                 `json:"currency,omitempty"`
+	Currency        Currency               `json:"currency,omitempty"`
 	IPAddress       string                 `json:"ip_address,omitempty"`
 	Log             map[string]interface{} `json:"log,omitempty"` // TODO: same as timeline?
-	Fees            int                    `json:"int,omitempty"`
+	Fees            Amount                 `json:"fees,omitempty"`
 	FeesSplit       string                 `json:"fees_split,omitempty"` // TODO: confirm data type
 	Customer        Customer               `json:"customer,omitempty"`
 	Authorization   Authorization          `json:"authorization,omitempty"`
@@ -80,6 +154,59 @@ type Authorization struct {
 	Signature         string `json:"signature,omitempty"`
 }
 
+// InitializeResult is the result of initializing a transaction
+type InitializeResult struct {
+	// AuthorizationURL is the checkout page to redirect the customer to
+	AuthorizationURL string `json:"authorization_url,omitempty"`
+	AccessCode       string `json:"access_code,omitempty"`
+	Reference        string `json:"reference,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// CurrencyAmount is an amount in a given currency
+type CurrencyAmount struct {
+	Currency Currency `json:"currency,omitempty"`
+	Amount   Amount   `json:"amount,omitempty"`
+}
+
+// TransactionTotals is the total amount received on the integration
+type TransactionTotals struct {
+	TotalTransactions          int              `json:"total_transactions,omitempty"`
+	UniqueCustomers            int              `json:"unique_customers,omitempty"`
+	TotalVolume                Amount           `json:"total_volume,omitempty"`
+	TotalVolumeByCurrency      []CurrencyAmount `json:"total_volume_by_currency,omitempty"`
+	PendingTransfers           Amount           `json:"pending_transfers,omitempty"`
+	PendingTransfersByCurrency []CurrencyAmount `json:"pending_transfers_by_currency,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// ExportResult is the result of exporting transactions
+type ExportResult struct {
+	// Path is the URL of the exported CSV file
+	Path string `json:"path,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// ReAuthorizeResult is the result of requesting reauthorization
+type ReAuthorizeResult struct {
+	// ReauthorizationURL is the page where the customer approves the charge
+	ReauthorizationURL string `json:"reauthorization_url,omitempty"`
+	Reference          string `json:"reference,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
+// CheckAuthorizationResult is the amount an authorization can be charged
+type CheckAuthorizationResult struct {
+	Amount   Amount   `json:"amount,omitempty"`
+	Currency Currency `json:"currency,omitempty"`
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
 // TransactionTimeline represents a timeline of events in a transaction session
 type TransactionTimeline struct {
 	TimeSpent      int                      `json:"time_spent,omitempty"`
@@ -95,95 +222,343 @@ type TransactionTimeline struct {
 
 // Initialize initiates a transaction process
 // For more details see https://developers.paystack.co/v1.0/reference#initialize-a-transaction
-func (s *TransactionService) Initialize(txn *TransactionRequest) (Response, error) {
+func (s *TransactionService) Initialize(txn *TransactionRequest) (*InitializeResult, error) {
+	return s.InitializeWithContext(context.Background(), txn)
+}
+
+// InitializeWithContext is like Initialize but carries ctx through to the request
+func (s *TransactionService) InitializeWithContext(ctx context.Context, txn *TransactionRequest) (*InitializeResult, error) {
+	if txn == nil {
+		return nil, newRequestValidationError("request", "transaction request is required")
+	}
 	u := fmt.Sprintf("/transaction/initialize")
-	resp := Response{}
-	err := s.client.Call("POST", u, txn, &resp)
-	return resp, err
+	req := *txn
+	req.Currency = s.client.currency(req.Currency)
+	if err := checkCurrency(req.Currency, req.Amount, CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
+	result := &InitializeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Transaction.Initialize", "POST", u, &req, result)
+	return result, err
 }
 
 // Verify checks that transaction with the given reference exists
 // For more details see https://api.paystack.co/transaction/verify/reference
 func (s *TransactionService) Verify(reference string) (*Transaction, error) {
+	return s.VerifyWithContext(context.Background(), reference)
+}
+
+// VerifyWithContext is like Verify but carries ctx through to the request
+func (s *TransactionService) VerifyWithContext(ctx context.Context, reference string) (*Transaction, error) {
 	u := fmt.Sprintf("/transaction/verify/%s", reference)
 	txn := &Transaction{}
-	err := s.client.Call("GET", u, nil, txn)
+	err := s.client.call(ctx, "Transaction.Verify", "GET", u, nil, txn)
 	return txn, err
 }
 
 // List returns a list of transactions.
 // For more details see https://paystack.com/docs/api/#transaction-list
 func (s *TransactionService) List() (*TransactionList, error) {
-	return s.ListN(10, 1)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *TransactionService) ListWithContext(ctx context.Context) (*TransactionList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of transactions
 // For more details see https://developers.paystack.co/v1.0/reference#list-transactions
 func (s *TransactionService) ListN(count, offset int) (*TransactionList, error) {
-	u := paginateURL("/transaction", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *TransactionService) ListNWithContext(ctx context.Context, count, offset int) (*TransactionList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of transactions matching params
+func (s *TransactionService) ListNWithParams(count, offset int, params *TransactionListParams) (*TransactionList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *TransactionService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *TransactionListParams) (*TransactionList, error) {
+	u := paginateURL("/transaction", count, offset, params.values())
 	txns := &TransactionList{}
-	err := s.client.Call("GET", u, nil, txns)
+	err := s.client.call(ctx, "Transaction.ListN", "GET", u, nil, txns)
 	return txns, err
 }
 
+// ListAll returns an iterator over all transactions, fetching pages as needed
+func (s *TransactionService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Transaction] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all transactions matching params
+func (s *TransactionService) ListAllWithParams(ctx context.Context, params *TransactionListParams, opts *ListOptions) *Iterator[Transaction] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Transaction, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
+
 // Get returns the details of a transaction.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-transaction
 func (s *TransactionService) Get(id int) (*Transaction, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *TransactionService) GetWithContext(ctx context.Context, id int) (*Transaction, error) {
 	u := fmt.Sprintf("/transaction/%d", id)
 	txn := &Transaction{}
-	err := s.client.Call("GET", u, nil, txn)
+	err := s.client.call(ctx, "Transaction.Get", "GET", u, nil, txn)
 	return txn, err
 }
 
 // ChargeAuthorization is for charging all  authorizations marked as reusable whenever you need to recieve payments.
+// A reference is generated if req.Reference is empty and reused on retries.
 // For more details see https://developers.paystack.co/v1.0/reference#charge-authorization
 func (s *TransactionService) ChargeAuthorization(req *TransactionRequest) (*Transaction, error) {
+	return s.ChargeAuthorizationWithContext(context.Background(), req)
+}
+
+// ChargeAuthorizationWithContext is like ChargeAuthorization but carries ctx through to the request
+func (s *TransactionService) ChargeAuthorizationWithContext(ctx context.Context, req *TransactionRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, newRequestValidationError("request", "transaction request is required")
+	}
+	ctx = withReference(ctx, &req.Reference)
+	body := *req
+	body.Currency = s.client.currency(body.Currency)
+	if err := checkCurrency(body.Currency, body.Amount, CurrencyInfo.ValidateCharge); err != nil {
+		return nil, err
+	}
 	txn := &Transaction{}
-	err := s.client.Call("POST", "/transaction/charge_authorization", req, txn)
+	err := s.client.call(ctx, "Transaction.ChargeAuthorization", "POST", "/transaction/charge_authorization", &body, txn)
 	return txn, err
 }
 
 // Timeline fetches the transaction timeline. Reference can be ID or transaction reference
 // For more details see https://developers.paystack.co/v1.0/reference#view-transaction-timeline
 func (s *TransactionService) Timeline(reference string) (*TransactionTimeline, error) {
+	return s.TimelineWithContext(context.Background(), reference)
+}
+
+// TimelineWithContext is like Timeline but carries ctx through to the request
+func (s *TransactionService) TimelineWithContext(ctx context.Context, reference string) (*TransactionTimeline, error) {
 	u := fmt.Sprintf("/transaction/timeline/%s", reference)
 	timeline := &TransactionTimeline{}
-	err := s.client.Call("GET", u, nil, timeline)
+	err := s.client.call(ctx, "Transaction.Timeline", "GET", u, nil, timeline)
 	return timeline, err
 }
 
 // Totals returns total amount received on your account
 // For more details see https://developers.paystack.co/v1.0/reference#transaction-totals
-func (s *TransactionService) Totals() (Response, error) {
+func (s *TransactionService) Totals() (*TransactionTotals, error) {
+	return s.TotalsWithContext(context.Background())
+}
+
+// TotalsWithContext is like Totals but carries ctx through to the request
+func (s *TransactionService) TotalsWithContext(ctx context.Context) (*TransactionTotals, error) {
 	u := fmt.Sprintf("/transaction/totals")
-	resp := Response{}
-	err := s.client.Call("GET", u, nil, &resp)
-	return resp, err
+	totals := &TransactionTotals{}
+	var err error
+	totals.Raw, err = s.client.callRaw(ctx, "Transaction.Totals", "GET", u, nil, totals)
+	return totals, err
 }
 
 // Export exports transactions to a downloadable file and returns a link to the file
 // For more details see https://developers.paystack.co/v1.0/reference#export-transactions
-func (s *TransactionService) Export(params RequestValues) (Response, error) {
-	u := fmt.Sprintf("/transaction/export")
-	resp := Response{}
-	err := s.client.Call("GET", u, nil, &resp)
-	return resp, err
+func (s *TransactionService) Export(params *ExportParams) (*ExportResult, error) {
+	return s.ExportWithContext(context.Background(), params)
+}
+
+// ExportWithContext is like Export but carries ctx through to the request
+func (s *TransactionService) ExportWithContext(ctx context.Context, params *ExportParams) (*ExportResult, error) {
+	u := "/transaction/export"
+	if q := params.values(); len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	result := &ExportResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Transaction.Export", "GET", u, nil, result)
+	return result, err
 }
 
 // ReAuthorize requests reauthorization
 // For more details see https://developers.paystack.co/v1.0/reference#request-reauthorization
-func (s *TransactionService) ReAuthorize(req AuthorizationRequest) (Response, error) {
+func (s *TransactionService) ReAuthorize(req AuthorizationRequest) (*ReAuthorizeResult, error) {
+	return s.ReAuthorizeWithContext(context.Background(), req)
+}
+
+// ReAuthorizeWithContext is like ReAuthorize but carries ctx through to the request
+func (s *TransactionService) ReAuthorizeWithContext(ctx context.Context, req AuthorizationRequest) (*ReAuthorizeResult, error) {
 	u := fmt.Sprintf("/transaction/request_reauthorization")
-	resp := Response{}
-	err := s.client.Call("POST", u, nil, &resp)
-	return resp, err
+	req.Currency = s.client.currency(req.Currency)
+	result := &ReAuthorizeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Transaction.ReAuthorize", "POST", u, &req, result)
+	return result, err
 }
 
 // CheckAuthorization checks authorization
 // For more details see https://developers.paystack.co/v1.0/reference#check-authorization
-func (s *TransactionService) CheckAuthorization(req AuthorizationRequest) (Response, error) {
+func (s *TransactionService) CheckAuthorization(req AuthorizationRequest) (*CheckAuthorizationResult, error) {
+	return s.CheckAuthorizationWithContext(context.Background(), req)
+}
+
+// CheckAuthorizationWithContext is like CheckAuthorization but carries ctx through to the request
+func (s *TransactionService) CheckAuthorizationWithContext(ctx context.Context, req AuthorizationRequest) (*CheckAuthorizationResult, error) {
 	u := fmt.Sprintf("/transaction/check_reauthorization")
-	resp := Response{}
-	err := s.client.Call("POST", u, nil, &resp)
-	return resp, err
+	req.Currency = s.client.currency(req.Currency)
+	result := &CheckAuthorizationResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Transaction.CheckAuthorization", "POST", u, &req, result)
+	return result, err
+}
+
+// DownloadExport downloads the CSV file at path, as returned by Export, and
+// parses it into transactions. Only the columns of the file are set on each
+// transaction, with the customer's email in Customer.Email. Amounts in the
+// file are in major units and are converted to minor units.
+func (s *TransactionService) DownloadExport(path string) ([]Transaction, error) {
+	return s.DownloadExportWithContext(context.Background(), path)
+}
+
+// DownloadExportWithContext is like DownloadExport but carries ctx through to the request
+func (s *TransactionService) DownloadExportWithContext(ctx context.Context, path string) ([]Transaction, error) {
+	// the file is served from a signed URL that must not get the secret key
+	req, err := http.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, &NetworkError{Err: err}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, newAPIError(resp, body, ErrorResponse{Message: "cannot download export"})
+	}
+	return parseExport(resp.Body)
+}
+
+// parseExport reads an exported CSV file. Columns are matched by name so
+// their order does not matter and unknown ones are skipped. Names are
+// compared ignoring case, spaces and underscores, so both "customer_email"
+// and "Customer Email" are read. Amounts are decimal major units, as shown
+// on the dashboard, and are converted to minor units of the row's currency.
+func parseExport(r io.Reader) ([]Transaction, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = exportColumn(name)
+	}
+	var txns []Transaction
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return txns, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var txn Transaction
+		var amounts []int
+		for i, v := range record {
+			if columns[i] == "amount" || columns[i] == "fees" {
+				// parsed once the currency is known
+				amounts = append(amounts, i)
+				continue
+			}
+			if err := setExportField(&txn, columns[i], v); err != nil {
+				return nil, fmt.Errorf("paystack: export line %d: %s: %v", line, header[i], err)
+			}
+		}
+		for _, i := range amounts {
+			a, err := parseExportAmount(record[i], txn.Currency)
+			if err != nil {
+				return nil, fmt.Errorf("paystack: export line %d: %s: %v", line, header[i], err)
+			}
+			if columns[i] == "amount" {
+				txn.Amount = a
+			} else {
+				txn.Fees = a
+			}
+		}
+		txns = append(txns, txn)
+	}
+}
+
+// exportColumn maps a column header to the name it is known by in
+// setExportField, or returns it normalised if it is not known
+func exportColumn(name string) string {
+	key := strings.ToLower(strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.TrimSpace(name)))
+	switch key {
+	case "id", "transactionid":
+		return "id"
+	case "reference", "transactionreference":
+		return "reference"
+	case "amount", "amountpaid":
+		return "amount"
+	case "fees", "fee":
+		return "fees"
+	case "customeremail", "email":
+		return "customer_email"
+	case "gatewayresponse":
+		return "gateway_response"
+	case "createdat", "transactiondate", "date":
+		return "created_at"
+	case "paidat", "paiddate":
+		return "paid_at"
+	}
+	return key
+}
+
+func setExportField(txn *Transaction, column, v string) error {
+	var err error
+	switch column {
+	case "id":
+		txn.ID, err = strconv.Atoi(v)
+	case "reference":
+		txn.Reference = v
+	case "currency":
+		txn.Currency = Currency(v)
+	case "status":
+		txn.Status = v
+	case "channel":
+		txn.Channel = v
+	case "gateway_response":
+		txn.GatewayResponse = v
+	case "customer_email":
+		txn.Customer.Email = v
+	case "created_at":
+		txn.CreatedAt = v
+	case "paid_at":
+		txn.PaidAt = v
+	}
+	return err
+}
+
+// parseExportAmount reads an amount in major units of cur
+func parseExportAmount(v string, cur Currency) (Amount, error) {
+	if v == "" {
+		return 0, nil
+	}
+	return parseAmount(v, cur.exponent())
 }
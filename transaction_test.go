@@ -1,7 +1,14 @@
 package paystack
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 )
@@ -10,6 +17,34 @@ func makeTimestamp() int64 {
 	return time.Now().UnixNano() / int64(time.Millisecond)
 }
 
+func TestAuthorizationDefaultCurrency(t *testing.T) {
+	sent := map[string]map[string]interface{}{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		sent[r.URL.Path] = body
+		w.Write([]byte(`{"status":true,"message":"ok","data":{}}`))
+	}))
+	defer ts.Close()
+	client, err := NewClientWithOptions("sk_test_key", WithBaseURL(ts.URL), WithDefaultCurrency(GHS))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := AuthorizationRequest{AuthorizationCode: "AUTH_1", Email: "user@example.com", Amount: 10000}
+	if _, err := client.Transaction.ReAuthorize(req); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Transaction.CheckAuthorization(req); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/transaction/request_reauthorization", "/transaction/check_reauthorization"} {
+		if sent[path]["currency"] != "GHS" {
+			t.Errorf("Expected the default currency for %s, got %v", path, sent[path])
+		}
+	}
+}
+
 func TestInitializeTransaction(t *testing.T) {
 	txn := &TransactionRequest{
 		Email:     "user123@gmail.com",
@@ -21,26 +56,26 @@ func TestInitializeTransaction(t *testing.T) {
 		t.Error(err)
 	}
 
-	if resp["authorization_code"] == "" {
-		t.Error("Missing transaction authorization code")
+	if resp.AuthorizationURL == "" {
+		t.Error("Missing transaction authorization URL")
 	}
 
-	if resp["access_code"] == "" {
+	if resp.AccessCode == "" {
 		t.Error("Missing transaction access code")
 	}
 
-	if resp["reference"] == "" {
+	if resp.Reference == "" {
 		t.Error("Missing transaction reference")
 	}
 
-	txn1, err := c.Transaction.Verify(resp["reference"].(string))
+	txn1, err := c.Transaction.Verify(resp.Reference)
 
 	if err != nil {
 		t.Error(err)
 	}
 
 	if txn1.Amount != txn.Amount {
-		t.Errorf("Expected transaction amount %f, got %+v", txn.Amount, txn1.Amount)
+		t.Errorf("Expected transaction amount %v, got %v", txn.Amount, txn1.Amount)
 	}
 
 	if txn1.Reference == "" {
@@ -54,6 +89,18 @@ func TestInitializeTransaction(t *testing.T) {
 	}
 }
 
+func TestInitializeNilRequest(t *testing.T) {
+	if _, err := c.Transaction.Initialize(nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil request to fail validation, got %v", err)
+	}
+}
+
+func TestChargeAuthorizationNilRequest(t *testing.T) {
+	if _, err := c.Transaction.ChargeAuthorization(nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil request to fail validation, got %v", err)
+	}
+}
+
 func TestTransactionList(t *testing.T) {
 	// retrieve the transaction list
 	transactions, err := c.Transaction.List()
@@ -63,9 +110,15 @@ func TestTransactionList(t *testing.T) {
 }
 
 func TestTransactionTotals(t *testing.T) {
-	_, err := c.Transaction.Totals()
+	totals, err := c.Transaction.Totals()
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if _, ok := totals.Raw["total_volume"]; !ok {
+		t.Errorf("Expected raw totals to be kept, got %v", totals.Raw)
+	}
+	if totals.TotalVolume > 0 && len(totals.TotalVolumeByCurrency) == 0 {
+		t.Errorf("Expected volume by currency, got %+v", totals)
 	}
 }
 
@@ -75,7 +128,104 @@ func TestExportTransaction(t *testing.T) {
 		t.Error(err)
 	}
 
-	if _, ok := resp["path"]; !ok {
+	if resp.Path == "" {
 		t.Error("Expected transactiion export path")
 	}
 }
+
+func TestTransactionListParams(t *testing.T) {
+	var queries []url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Write([]byte(`{"status":true,"message":"ok","data":[{"id":1}],"meta":{"total":2,"perPage":1,"page":1,"pageCount":2}}`))
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts.URL)
+	params := &TransactionListParams{
+		Status:   "success",
+		Customer: 42,
+		From:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		Amount:   500000,
+	}
+	if _, err := client.Transaction.ListNWithParams(20, 3, params); err != nil {
+		t.Fatal(err)
+	}
+
+	want := url.Values{
+		"status":   {"success"},
+		"customer": {"42"},
+		"from":     {"2024-01-01T00:00:00Z"},
+		"to":       {"2024-01-31T00:00:00Z"},
+		"amount":   {"500000"},
+		"perPage":  {"20"},
+		"page":     {"3"},
+	}
+	if queries[0].Encode() != want.Encode() {
+		t.Errorf("Expected query %v, got %v", want.Encode(), queries[0].Encode())
+	}
+
+	it := client.Transaction.ListAllWithParams(context.Background(), &TransactionListParams{Status: "failed"}, &ListOptions{PerPage: 1})
+	for it.Next() {
+	}
+	if len(queries) != 3 || queries[2].Get("status") != "failed" || queries[2].Get("page") != "2" {
+		t.Errorf("Expected filters on every page request, got %v", queries[1:])
+	}
+}
+
+func TestExportParams(t *testing.T) {
+	var query url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"status":true,"message":"Export successful","data":{"path":"https://files.example/export.csv"}}`))
+	}))
+	defer ts.Close()
+	client := newTestClient(ts.URL)
+
+	settled := false
+	_, err := client.Transaction.Export(&ExportParams{
+		From:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:     "success",
+		Currency:   GHS,
+		Settled:    &settled,
+		Settlement: 12,
+		Customer:   34,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := url.Values{
+		"from": {"2024-01-01T00:00:00Z"}, "status": {"success"}, "currency": {"GHS"},
+		"settled": {"false"}, "settlement": {"12"}, "customer": {"34"},
+	}
+	if query.Encode() != want.Encode() {
+		t.Errorf("Expected query %v, got %v", want, query)
+	}
+}
+
+func TestParseExport(t *testing.T) {
+	csv := "reference,amount,status,customer_email,unknown\nref-1,5000,success,ada@example.com,x\nref-2,,failed,bob@example.com,y\n"
+	txns, err := parseExport(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txns) != 2 || txns[0].Amount != 500000 || txns[1].Status != "failed" || txns[1].Customer.Email != "bob@example.com" {
+		t.Errorf("Unexpected transactions %+v", txns)
+	}
+
+	// dashboard style headers, with decimal amounts in major units
+	csv = "Transaction Date,Reference,Amount,Fees,Currency,Customer Email,Gateway Response\n" +
+		"2024-03-01 10:00:00,ref-3,\"5,000.50\",75.01,NGN,ada@example.com,Approved\n"
+	if txns, err = parseExport(strings.NewReader(csv)); err != nil {
+		t.Fatal(err)
+	}
+	if len(txns) != 1 || txns[0].Amount != 500050 || txns[0].Fees != 7501 || txns[0].Customer.Email != "ada@example.com" ||
+		txns[0].CreatedAt != "2024-03-01 10:00:00" || txns[0].GatewayResponse != "Approved" {
+		t.Errorf("Unexpected transactions %+v", txns)
+	}
+
+	if _, err := parseExport(strings.NewReader("amount\nlots\n")); err == nil {
+		t.Error("Expected a bad amount to fail")
+	}
+}
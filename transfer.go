@@ -1,8 +1,10 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"time"
 )
 
 // TransferService handles operations related to the transfer
@@ -10,27 +12,30 @@ import (
 type TransferService service
 
 // TransferRequest represents a request to create a transfer.
+// If Reference is empty, Initiate generates one and stores it on the request,
+// so that resubmitting the same request cannot pay out twice.
 type TransferRequest struct {
-	Source    string  `json:"source,omitempty"`
-	Amount    float32 `json:"amount,omitempty"`
-	Currency  string  `json:"currency,omitempty"`
-	Reason    string  `json:"reason,omitempty"`
-	Recipient string  `json:"recipient,omitempty"`
+	Source    string   `json:"source,omitempty"`
+	Amount    Amount   `json:"amount,omitempty"`
+	Currency  Currency `json:"currency,omitempty"`
+	Reason    string   `json:"reason,omitempty"`
+	Recipient string   `json:"recipient,omitempty"`
+	Reference string   `json:"reference,omitempty"`
 }
 
 // Transfer is the resource representing your Paystack transfer.
 // For more details see https://developers.paystack.co/v1.0/reference#initiate-transfer
 type Transfer struct {
-	ID           int     `json:"id,omitempty"`
-	CreatedAt    string  `json:"createdAt,omitempty"`
-	UpdatedAt    string  `json:"updatedAt,omitempty"`
-	Domain       string  `json:"domain,omitempty"`
-	Integration  int     `json:"integration,omitempty"`
-	Source       string  `json:"source,omitempty"`
-	Amount       float32 `json:"amount,omitempty"`
-	Currency     string  `json:"currency,omitempty"`
-	Reason       string  `json:"reason,omitempty"`
-	TransferCode string  `json:"transfer_code,omitempty"`
+	ID           int      `json:"id,omitempty"`
+	CreatedAt    string   `json:"createdAt,omitempty"`
+	UpdatedAt    string   `json:"updatedAt,omitempty"`
+	Domain       string   `json:"domain,omitempty"`
+	Integration  int      `json:"integration,omitempty"`
+	Source       string   `json:"source,omitempty"`
+	Amount       Amount   `json:"amount,omitempty"`
+	Currency     Currency `json:"currency,omitempty"`
+	Reason       string   `json:"reason,omitempty"`
+	TransferCode string   `json:"transfer_code,omitempty"`
 	// Initiate returns recipient ID as recipient value, Fetch returns recipient object
 	Recipient interface{} `json:"recipient,omitempty"`
 	Status    string      `json:"status,omitempty"`
@@ -41,18 +46,25 @@ type Transfer struct {
 	TitanCode     string      `json:"titan_code,omitempty"`
 }
 
+// TransferFinalizeResult is a transfer completed with Finalize
+type TransferFinalizeResult struct {
+	Transfer Transfer
+	// Raw is the payload as returned by Paystack
+	Raw Response `json:"-"`
+}
+
 // TransferRecipient represents a Paystack transfer recipient
 // For more details see https://developers.paystack.co/v1.0/reference#create-transfer-recipient
 type TransferRecipient struct {
 	ID            int                    `json:"id,omitempty"`
 	CreatedAt     string                 `json:"createdAt,omitempty"`
 	UpdatedAt     string                 `json:"updatedAt,omitempty"`
-	Type          string                 `json:",omitempty"`
+	Type          string                 `json:"type,omitempty"`
 	Name          string                 `json:"name,omitempty"`
 	Metadata      Metadata               `json:"metadata,omitempty"`
 	AccountNumber string                 `json:"account_number,omitempty"`
 	BankCode      string                 `json:"bank_code,omitempty"`
-	Currency      string                 `json:"currency,omitempty"`
+	Currency      Currency               `json:"currency,omitempty"`
 	Description   string                 `json:"description,omitempty"`
 	Active        bool                   `json:"active,omitempty"`
 	Details       map[string]interface{} `json:"details,omitempty"`
@@ -63,7 +75,7 @@ type TransferRecipient struct {
 // BulkTransfer represents a Paystack bulk transfer
 // You need to disable the Transfers OTP requirement to use this endpoint
 type BulkTransfer struct {
-	Currency  string                   `json:"currency,omitempty"`
+	Currency  Currency                 `json:"currency,omitempty"`
 	Source    string                   `json:"source,omitempty"`
 	Transfers []map[string]interface{} `json:"transfers,omitempty"`
 }
@@ -74,6 +86,30 @@ type TransferList struct {
 	Values []Transfer `json:"data,omitempty"`
 }
 
+// TransferListParams filters the transfers returned by ListNWithParams and ListAllWithParams
+type TransferListParams struct {
+	// Status is one of "pending", "success", "failed", "reversed" or "otp"
+	Status string
+	// Customer is the ID of the customer the transfers were made to
+	Customer int
+	From     time.Time
+	To       time.Time
+}
+
+func (p *TransferListParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != "" {
+		q.Set("status", p.Status)
+	}
+	setInt(q, "customer", int64(p.Customer))
+	setTime(q, "from", p.From)
+	setTime(q, "to", p.To)
+	return q
+}
+
 // TransferRecipientList is a list object for transfer recipient.
 type TransferRecipientList struct {
 	Meta   ListMeta
@@ -83,65 +119,141 @@ type TransferRecipientList struct {
 // Initiate initiates a new transfer
 // For more details see https://developers.paystack.co/v1.0/reference#initiate-transfer
 func (s *TransferService) Initiate(req *TransferRequest) (*Transfer, error) {
+	return s.InitiateWithContext(context.Background(), req)
+}
+
+// InitiateWithContext is like Initiate but carries ctx through to the request
+func (s *TransferService) InitiateWithContext(ctx context.Context, req *TransferRequest) (*Transfer, error) {
+	if req == nil {
+		return nil, newRequestValidationError("request", "transfer request is required")
+	}
+	ctx = withReference(ctx, &req.Reference)
+	body := *req
+	body.Currency = s.client.currency(body.Currency)
+	if err := checkCurrency(body.Currency, body.Amount, CurrencyInfo.ValidateTransfer); err != nil {
+		return nil, err
+	}
 	transfer := &Transfer{}
-	err := s.client.Call("POST", "/transfer", req, transfer)
+	err := s.client.call(ctx, "Transfer.Initiate", "POST", "/transfer", &body, transfer)
 	return transfer, err
 }
 
 // Finalize completes a transfer request
 // For more details see https://developers.paystack.co/v1.0/reference#finalize-transfer
-func (s *TransferService) Finalize(code, otp string) (Response, error) {
+func (s *TransferService) Finalize(code, otp string) (*TransferFinalizeResult, error) {
+	return s.FinalizeWithContext(context.Background(), code, otp)
+}
+
+// FinalizeWithContext is like Finalize but carries ctx through to the request
+func (s *TransferService) FinalizeWithContext(ctx context.Context, code, otp string) (*TransferFinalizeResult, error) {
 	u := fmt.Sprintf("/transfer/finalize_transfer")
 	req := url.Values{}
 	req.Add("transfer_code", code)
 	req.Add("otp", otp)
-	resp := Response{}
-	err := s.client.Call("POST", u, req, &resp)
-	return resp, err
+	result := &TransferFinalizeResult{}
+	var err error
+	result.Raw, err = s.client.callRaw(ctx, "Transfer.Finalize", "POST", u, req, &result.Transfer)
+	return result, err
 }
 
 // MakeBulkTransfer initiates a new bulk transfer request
 // You need to disable the Transfers OTP requirement to use this endpoint
 // For more details see https://developers.paystack.co/v1.0/reference#initiate-bulk-transfer
 func (s *TransferService) MakeBulkTransfer(req *BulkTransfer) (Response, error) {
+	return s.MakeBulkTransferWithContext(context.Background(), req)
+}
+
+// MakeBulkTransferWithContext is like MakeBulkTransfer but carries ctx through to the request
+func (s *TransferService) MakeBulkTransferWithContext(ctx context.Context, req *BulkTransfer) (Response, error) {
+	if req == nil {
+		return nil, newRequestValidationError("request", "bulk transfer is required")
+	}
 	u := fmt.Sprintf("/transfer")
+	body := *req
+	body.Currency = s.client.currency(body.Currency)
+	if err := checkCurrency(body.Currency, 0, nil); err != nil {
+		return nil, err
+	}
 	resp := Response{}
-	err := s.client.Call("POST", u, req, &resp)
+	err := s.client.call(ctx, "Transfer.MakeBulkTransfer", "POST", u, &body, &resp)
 	return resp, err
 }
 
 // Get returns the details of a transfer.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-transfer
 func (s *TransferService) Get(idCode string) (*Transfer, error) {
+	return s.GetWithContext(context.Background(), idCode)
+}
+
+// GetWithContext is like Get but carries ctx through to the request
+func (s *TransferService) GetWithContext(ctx context.Context, idCode string) (*Transfer, error) {
 	u := fmt.Sprintf("/transfer/%s", idCode)
 	transfer := &Transfer{}
-	err := s.client.Call("GET", u, nil, transfer)
+	err := s.client.call(ctx, "Transfer.Get", "GET", u, nil, transfer)
 	return transfer, err
 }
 
 // List returns a list of transfers.
 // For more details see https://developers.paystack.co/v1.0/reference#list-transfers
 func (s *TransferService) List() (*TransferList, error) {
-	return s.ListN(10, 0)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the request
+func (s *TransferService) ListWithContext(ctx context.Context) (*TransferList, error) {
+	return s.ListNWithContext(ctx, 10, 1)
 }
 
 // ListN returns a list of transfers
 // For more details see https://developers.paystack.co/v1.0/reference#list-transfers
 func (s *TransferService) ListN(count, offset int) (*TransferList, error) {
-	u := paginateURL("/transfer", count, offset)
+	return s.ListNWithContext(context.Background(), count, offset)
+}
+
+// ListNWithContext is like ListN but carries ctx through to the request
+func (s *TransferService) ListNWithContext(ctx context.Context, count, offset int) (*TransferList, error) {
+	return s.ListNWithParamsWithContext(ctx, count, offset, nil)
+}
+
+// ListNWithParams returns a page of transfers matching params
+func (s *TransferService) ListNWithParams(count, offset int, params *TransferListParams) (*TransferList, error) {
+	return s.ListNWithParamsWithContext(context.Background(), count, offset, params)
+}
+
+// ListNWithParamsWithContext is like ListNWithParams but carries ctx through to the request
+func (s *TransferService) ListNWithParamsWithContext(ctx context.Context, count, offset int, params *TransferListParams) (*TransferList, error) {
+	u := paginateURL("/transfer", count, offset, params.values())
 	transfers := &TransferList{}
-	err := s.client.Call("GET", u, nil, transfers)
+	err := s.client.call(ctx, "Transfer.ListN", "GET", u, nil, transfers)
 	return transfers, err
 }
 
+// ListAll returns an iterator over all transfers, fetching pages as needed
+func (s *TransferService) ListAll(ctx context.Context, opts *ListOptions) *Iterator[Transfer] {
+	return s.ListAllWithParams(ctx, nil, opts)
+}
+
+// ListAllWithParams returns an iterator over all transfers matching params
+func (s *TransferService) ListAllWithParams(ctx context.Context, params *TransferListParams, opts *ListOptions) *Iterator[Transfer] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]Transfer, ListMeta, error) {
+		list, err := s.ListNWithParamsWithContext(ctx, count, page, params)
+		return list.Values, list.Meta, err
+	})
+}
+
 // ResendOTP generates a new OTP and sends to customer in the event they are having trouble receiving one.
 // For more details see https://developers.paystack.co/v1.0/reference#resend-otp-for-transfer
 func (s *TransferService) ResendOTP(transferCode, reason string) (Response, error) {
+	return s.ResendOTPWithContext(context.Background(), transferCode, reason)
+}
+
+// ResendOTPWithContext is like ResendOTP but carries ctx through to the request
+func (s *TransferService) ResendOTPWithContext(ctx context.Context, transferCode, reason string) (Response, error) {
 	data := url.Values{}
 	data.Add("transfer_code", transferCode)
 	data.Add("reason", reason)
 	resp := Response{}
-	err := s.client.Call("POST", "/transfer/resend_otp", data, &resp)
+	err := s.client.call(ctx, "Transfer.ResendOTP", "POST", "/transfer/resend_otp", data, &resp)
 	return resp, err
 }
 
@@ -150,8 +262,13 @@ func (s *TransferService) ResendOTP(transferCode, reason string) (Response, erro
 // transfers programmatically, this endpoint helps turn OTP requirement back on.
 // No arguments required.
 func (s *TransferService) EnableOTP() (Response, error) {
+	return s.EnableOTPWithContext(context.Background())
+}
+
+// EnableOTPWithContext is like EnableOTP but carries ctx through to the request
+func (s *TransferService) EnableOTPWithContext(ctx context.Context) (Response, error) {
 	resp := Response{}
-	err := s.client.Call("POST", "/transfer/enable_otp", nil, &resp)
+	err := s.client.call(ctx, "Transfer.EnableOTP", "POST", "/transfer/enable_otp", nil, &resp)
 	return resp, err
 }
 
@@ -160,40 +277,81 @@ func (s *TransferService) EnableOTP() (Response, error) {
 // programmatically without use of OTPs, this endpoint helps disable that….
 // with an OTP. No arguments required. You will get an OTP.
 func (s *TransferService) DisableOTP() (Response, error) {
+	return s.DisableOTPWithContext(context.Background())
+}
+
+// DisableOTPWithContext is like DisableOTP but carries ctx through to the request
+func (s *TransferService) DisableOTPWithContext(ctx context.Context) (Response, error) {
 	resp := Response{}
-	err := s.client.Call("POST", "/transfer/disable_otp", nil, &resp)
+	err := s.client.call(ctx, "Transfer.DisableOTP", "POST", "/transfer/disable_otp", nil, &resp)
 	return resp, err
 }
 
 // FinalizeOTPDisable finalizes disabling of OTP requirement for Transfers
 // For more details see https://developers.paystack.co/v1.0/reference#finalize-disabling-of-otp-requirement-for-transfers
 func (s *TransferService) FinalizeOTPDisable(otp string) (Response, error) {
+	return s.FinalizeOTPDisableWithContext(context.Background(), otp)
+}
+
+// FinalizeOTPDisableWithContext is like FinalizeOTPDisable but carries ctx through to the request
+func (s *TransferService) FinalizeOTPDisableWithContext(ctx context.Context, otp string) (Response, error) {
 	data := url.Values{}
 	data.Add("otp", otp)
 	resp := Response{}
-	err := s.client.Call("POST", "/transfer/disable_otp_finalize", data, &resp)
+	err := s.client.call(ctx, "Transfer.FinalizeOTPDisable", "POST", "/transfer/disable_otp_finalize", data, &resp)
 	return resp, err
 }
 
 // CreateRecipient creates a new transfer recipient
 // For more details see https://developers.paystack.co/v1.0/reference#create-transferrecipient
 func (s *TransferService) CreateRecipient(recipient *TransferRecipient) (*TransferRecipient, error) {
+	return s.CreateRecipientWithContext(context.Background(), recipient)
+}
+
+// CreateRecipientWithContext is like CreateRecipient but carries ctx through to the request
+func (s *TransferService) CreateRecipientWithContext(ctx context.Context, recipient *TransferRecipient) (*TransferRecipient, error) {
+	if recipient == nil {
+		return nil, newRequestValidationError("request", "recipient is required")
+	}
+	req := *recipient
+	req.Currency = s.client.currency(req.Currency)
+	if err := checkCurrency(req.Currency, 0, nil); err != nil {
+		return nil, err
+	}
 	recipient1 := &TransferRecipient{}
-	err := s.client.Call("POST", "/transferrecipient", recipient, recipient1)
+	err := s.client.call(ctx, "Transfer.CreateRecipient", "POST", "/transferrecipient", &req, recipient1)
 	return recipient1, err
 }
 
 // ListRecipients returns a list of transfer recipients.
 // For more details see https://developers.paystack.co/v1.0/reference#list-transferrecipients
 func (s *TransferService) ListRecipients() (*TransferRecipientList, error) {
-	return s.ListRecipientsN(10, 1)
+	return s.ListRecipientsWithContext(context.Background())
+}
+
+// ListRecipientsWithContext is like ListRecipients but carries ctx through to the request
+func (s *TransferService) ListRecipientsWithContext(ctx context.Context) (*TransferRecipientList, error) {
+	return s.ListRecipientsNWithContext(ctx, 10, 1)
 }
 
 // ListRecipientsN returns a list of transfer recipients
 // For more details see https://developers.paystack.co/v1.0/reference#list-transferrecipients
 func (s *TransferService) ListRecipientsN(count, offset int) (*TransferRecipientList, error) {
-	u := paginateURL("/transferrecipient", count, offset)
+	return s.ListRecipientsNWithContext(context.Background(), count, offset)
+}
+
+// ListRecipientsNWithContext is like ListRecipientsN but carries ctx through to the request
+func (s *TransferService) ListRecipientsNWithContext(ctx context.Context, count, offset int) (*TransferRecipientList, error) {
+	u := paginateURL("/transferrecipient", count, offset, nil)
 	resp := &TransferRecipientList{}
-	err := s.client.Call("GET", u, nil, &resp)
+	err := s.client.call(ctx, "Transfer.ListRecipientsN", "GET", u, nil, &resp)
 	return resp, err
 }
+
+// ListRecipientsAll returns an iterator over all transfer recipients, fetching pages as needed
+func (s *TransferService) ListRecipientsAll(ctx context.Context, opts *ListOptions) *Iterator[TransferRecipient] {
+	return newIterator(ctx, opts, func(ctx context.Context, count, page int) ([]TransferRecipient, ListMeta, error) {
+		list, err := s.ListRecipientsNWithContext(ctx, count, page)
+		return list.Values, list.Meta, err
+	})
+}
@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -144,3 +145,15 @@ func createDemoRecipients() ([]*TransferRecipient, error) {
 
 	return []*TransferRecipient{recipient1, recipient2, recipient3}, err
 }
+
+func TestTransferNilRequests(t *testing.T) {
+	if _, err := c.Transfer.Initiate(nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil transfer to fail validation, got %v", err)
+	}
+	if _, err := c.Transfer.MakeBulkTransfer(nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil bulk transfer to fail validation, got %v", err)
+	}
+	if _, err := c.Transfer.CreateRecipient(nil); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a nil recipient to fail validation, got %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// PaystackIPs are the addresses Paystack sends webhooks from
+var PaystackIPs = []string{"52.31.139.75", "52.49.173.169", "52.214.14.220"}
+
+// AllowList rejects webhook requests that do not come from an allowed address.
+// It complements signature verification; it does not replace it.
+//
+// Behind a reverse proxy the connecting address is the proxy's, so the
+// client address is taken from X-Forwarded-For or X-Real-IP, but only when
+// the request arrives from a proxy marked as trusted with TrustProxies.
+type AllowList struct {
+	allowed []*net.IPNet
+	trusted []*net.IPNet
+}
+
+// NewAllowList returns an AllowList admitting the given IP addresses or CIDR
+// ranges. Without arguments it admits PaystackIPs.
+func NewAllowList(addrs ...string) (*AllowList, error) {
+	if len(addrs) == 0 {
+		addrs = PaystackIPs
+	}
+	allowed, err := parseNets(addrs)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowList{allowed: allowed}, nil
+}
+
+// TrustProxies marks the given IP addresses or CIDR ranges as proxies whose
+// forwarding headers are believed
+func (a *AllowList) TrustProxies(addrs ...string) error {
+	trusted, err := parseNets(addrs)
+	if err != nil {
+		return err
+	}
+	a.trusted = append(a.trusted, trusted...)
+	return nil
+}
+
+func parseNets(addrs []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(addrs))
+	for _, addr := range addrs {
+		addr = strings.TrimSpace(addr)
+		if !strings.Contains(addr, "/") {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				return nil, fmt.Errorf("webhook: invalid IP address %q", addr)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(addr)
+		if err != nil {
+			return nil, fmt.Errorf("webhook: invalid CIDR range %q", addr)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+func contains(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address the request originates from, following
+// forwarding headers through trusted proxies only
+func (a *AllowList) ClientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !contains(a.trusted, ip) {
+		return ip
+	}
+
+	// Walk X-Forwarded-For from the nearest hop back, skipping our own
+	// proxies; the first untrusted hop is the client.
+	var hops []string
+	for _, h := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(h, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			return nil
+		}
+		ip = hop
+		if !contains(a.trusted, hop) {
+			return hop
+		}
+	}
+	if len(hops) == 0 {
+		if real := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); real != nil {
+			return real
+		}
+	}
+	return ip
+}
+
+// Allowed reports whether the request comes from an allowed address
+func (a *AllowList) Allowed(r *http.Request) bool {
+	ip := a.ClientIP(r)
+	return ip != nil && contains(a.allowed, ip)
+}
+
+// Wrap returns a handler answering 403 to requests from addresses that are
+// not allowed and passing the others to next
+func (a *AllowList) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.Allowed(r) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAllowListDefaults(t *testing.T) {
+	a, err := NewAllowList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.RemoteAddr = "52.49.173.169:5000"
+	if !a.Allowed(r) {
+		t.Error("expected Paystack address to be allowed")
+	}
+	r.RemoteAddr = "203.0.113.7:5000"
+	if a.Allowed(r) {
+		t.Error("expected unknown address to be rejected")
+	}
+}
+
+func TestAllowListIgnoresUntrustedForwarding(t *testing.T) {
+	a, _ := NewAllowList()
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.RemoteAddr = "203.0.113.7:5000"
+	r.Header.Set("X-Forwarded-For", PaystackIPs[0])
+	if a.Allowed(r) {
+		t.Error("forwarding header from an untrusted peer should be ignored")
+	}
+}
+
+func TestAllowListTrustedProxies(t *testing.T) {
+	a, _ := NewAllowList()
+	if err := a.TrustProxies("10.0.0.0/8"); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		xff, realIP string
+		want        bool
+	}{
+		{PaystackIPs[1], "", true},
+		{PaystackIPs[1] + ", 10.0.0.2", "", true},
+		{"203.0.113.7, " + PaystackIPs[1], "", true},
+		{PaystackIPs[1] + ", 203.0.113.7", "", false},
+		{"", PaystackIPs[2], true},
+		{"", "203.0.113.7", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.RemoteAddr = "10.0.0.1:5000"
+		if c.xff != "" {
+			r.Header.Set("X-Forwarded-For", c.xff)
+		}
+		if c.realIP != "" {
+			r.Header.Set("X-Real-IP", c.realIP)
+		}
+		if got := a.Allowed(r); got != c.want {
+			t.Errorf("xff=%q real=%q: got %v, want %v", c.xff, c.realIP, got, c.want)
+		}
+	}
+}
+
+func TestAllowListWrap(t *testing.T) {
+	a, _ := NewAllowList("192.0.2.0/24")
+	h := a.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.RemoteAddr = "198.51.100.1:1"
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("got %d, want 403", w.Code)
+	}
+
+	r.RemoteAddr = "192.0.2.10:1"
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("got %d, want 200", w.Code)
+	}
+}
+
+func TestNewAllowListInvalid(t *testing.T) {
+	if _, err := NewAllowList("not-an-ip"); err == nil {
+		t.Error("expected error for invalid address")
+	}
+	if _, err := NewAllowList("10.0.0.0/99"); err == nil {
+		t.Error("expected error for invalid range")
+	}
+}
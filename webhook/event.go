@@ -0,0 +1,167 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+
+	"github.com/mitchellh/mapstructure"
+	paystack "github.com/rpip/paystack-go"
+)
+
+// EventType identifies the kind of a webhook event, e.g. "charge.success"
+type EventType string
+
+// Webhook events sent by Paystack
+const (
+	ChargeSuccess        EventType = "charge.success"
+	ChargeDisputeCreate  EventType = "charge.dispute.create"
+	ChargeDisputeRemind  EventType = "charge.dispute.remind"
+	ChargeDisputeResolve EventType = "charge.dispute.resolve"
+
+	TransferSuccess  EventType = "transfer.success"
+	TransferFailed   EventType = "transfer.failed"
+	TransferReversed EventType = "transfer.reversed"
+
+	SubscriptionCreate        EventType = "subscription.create"
+	SubscriptionDisable       EventType = "subscription.disable"
+	SubscriptionNotRenew      EventType = "subscription.not_renew"
+	SubscriptionExpiringCards EventType = "subscription.expiring_cards"
+
+	InvoiceCreate        EventType = "invoice.create"
+	InvoiceUpdate        EventType = "invoice.update"
+	InvoicePaymentFailed EventType = "invoice.payment_failed"
+
+	DedicatedAccountAssignSuccess EventType = "dedicatedaccount.assign.success"
+	DedicatedAccountAssignFailed  EventType = "dedicatedaccount.assign.failed"
+)
+
+// Event is a webhook event as delivered by Paystack
+type Event struct {
+	Type EventType       `json:"event"`
+	Data json.RawMessage `json:"data"`
+
+	// Raw is the request body the event was parsed from
+	Raw []byte `json:"-"`
+}
+
+// Invoice is the payload of invoice events, sent for subscription payments
+type Invoice struct {
+	Domain        string                 `json:"domain,omitempty"`
+	InvoiceCode   string                 `json:"invoice_code,omitempty"`
+	Amount        paystack.Amount        `json:"amount,omitempty"`
+	PeriodStart   string                 `json:"period_start,omitempty"`
+	PeriodEnd     string                 `json:"period_end,omitempty"`
+	Status        string                 `json:"status,omitempty"`
+	Paid          bool                   `json:"paid,omitempty"`
+	PaidAt        string                 `json:"paid_at,omitempty"`
+	Description   string                 `json:"description,omitempty"`
+	Authorization paystack.Authorization `json:"authorization,omitempty"`
+	Subscription  paystack.Subscription  `json:"subscription,omitempty"`
+	Customer      paystack.Customer      `json:"customer,omitempty"`
+	Transaction   paystack.Transaction   `json:"transaction,omitempty"`
+	CreatedAt     string                 `json:"created_at,omitempty"`
+}
+
+// Assignment is the payload of dedicatedaccount.assign events, sent once
+// an account requested with DedicatedAccountService.Assign is ready or
+// could not be opened
+type Assignment struct {
+	Customer         paystack.Customer          `json:"customer,omitempty"`
+	DedicatedAccount *paystack.DedicatedAccount `json:"dedicated_account,omitempty"`
+	Identification   struct {
+		Status        string `json:"status,omitempty"`
+		Type          string `json:"type,omitempty"`
+		Country       string `json:"country,omitempty"`
+		AccountNumber string `json:"account_number,omitempty"`
+		BankCode      string `json:"bank_code,omitempty"`
+	} `json:"identification,omitempty"`
+}
+
+// ErrNoEventType is returned when a payload has no "event" field
+var ErrNoEventType = errors.New("webhook: missing event type")
+
+// ParseEvent parses a webhook request body. It does not check the signature;
+// use VerifySignature or Handler for untrusted input.
+func ParseEvent(body []byte) (*Event, error) {
+	e := &Event{}
+	if err := json.Unmarshal(body, e); err != nil {
+		return nil, err
+	}
+	if e.Type == "" {
+		return nil, ErrNoEventType
+	}
+	e.Raw = body
+	return e, nil
+}
+
+// Decode decodes the event data into v, which should point to a struct from
+// the paystack package. Decoding is as lenient as for API responses, so that
+// fields whose shape differs between webhooks and the API do not fail it.
+func (e *Event) Decode(v interface{}) error {
+	var data interface{}
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return err
+	}
+	config := &mapstructure.DecoderConfig{
+		Result:           v,
+		TagName:          "json",
+		WeaklyTypedInput: true,
+		DecodeHook:       lenientHook,
+	}
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(data)
+}
+
+// lenientHook keeps objects sent where the structs expect a string as JSON
+// text (transaction metadata, for one) and treats an empty string sent in
+// place of an object as absent
+func lenientHook(from, to reflect.Kind, data interface{}) (interface{}, error) {
+	switch {
+	case to == reflect.String && (from == reflect.Map || from == reflect.Slice):
+		b, err := json.Marshal(data)
+		return string(b), err
+	case from == reflect.String && data == "" && (to == reflect.Map || to == reflect.Struct || to == reflect.Slice):
+		return nil, nil
+	}
+	return data, nil
+}
+
+// Transaction decodes the data of charge events
+func (e *Event) Transaction() (*paystack.Transaction, error) {
+	txn := &paystack.Transaction{}
+	return txn, e.Decode(txn)
+}
+
+// Transfer decodes the data of transfer events
+func (e *Event) Transfer() (*paystack.Transfer, error) {
+	transfer := &paystack.Transfer{}
+	return transfer, e.Decode(transfer)
+}
+
+// Subscription decodes the data of subscription events
+func (e *Event) Subscription() (*paystack.Subscription, error) {
+	sub := &paystack.Subscription{}
+	return sub, e.Decode(sub)
+}
+
+// Dispute decodes the data of charge.dispute events
+func (e *Event) Dispute() (*paystack.Dispute, error) {
+	dispute := &paystack.Dispute{}
+	return dispute, e.Decode(dispute)
+}
+
+// Assignment decodes the data of dedicatedaccount.assign events
+func (e *Event) Assignment() (*Assignment, error) {
+	a := &Assignment{}
+	return a, e.Decode(a)
+}
+
+// Invoice decodes the data of invoice events
+func (e *Event) Invoice() (*Invoice, error) {
+	inv := &Invoice{}
+	return inv, e.Decode(inv)
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileStore is an EventStore persisted to a local append-only log file, so
+// that deduplication state and events awaiting replay survive restarts.
+// Every change is appended as a JSON line and synced before it takes effect;
+// the file is read back into memory when opened. Use Compact to drop
+// superseded entries. A FileStore must only be opened by one process at a time.
+type FileStore struct {
+	*MemoryStore
+	path string
+	f    *os.File
+}
+
+// OpenFileStore opens or creates the store at path
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{MemoryStore: NewMemoryStore(), path: path}
+	if err := s.read(); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	s.f = f
+	s.persist = s.append
+	return s, nil
+}
+
+// read loads the log, letting later entries replace earlier ones
+func (s *FileStore) read() error {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; sc.Scan(); line++ {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		rec := &Record{}
+		if err := json.Unmarshal(sc.Bytes(), rec); err != nil {
+			return fmt.Errorf("webhook: %s line %d: %v", s.path, line, err)
+		}
+		_, seen := s.records[rec.Key]
+		s.load(rec, !seen)
+	}
+	return sc.Err()
+}
+
+// append writes rec to the log and syncs it to disk
+func (s *FileStore) append(rec *Record) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	if _, err := s.f.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	return s.f.Sync()
+}
+
+// Compact rewrites the log with only the latest state of each event.
+// Records received before olderThan that were processed are dropped when
+// olderThan is non-zero, bounding the size of the store.
+func (s *FileStore) Compact(olderThan time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	keep := s.order[:0:0]
+	w := bufio.NewWriter(tmp)
+	for _, key := range s.order {
+		rec := s.records[key]
+		if !olderThan.IsZero() && rec.Status == StatusProcessed && rec.ReceivedAt.Before(olderThan) {
+			continue
+		}
+		b, err := json.Marshal(rec)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		w.Write(append(b, '\n'))
+		keep = append(keep, key)
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	s.f.Close()
+	s.f = f
+
+	records := make(map[string]*Record, len(keep))
+	for _, key := range keep {
+		records[key] = s.records[key]
+	}
+	s.records, s.order = records, keep
+	return nil
+}
+
+// Close closes the log file
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
@@ -0,0 +1,291 @@
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrDuplicateEvent is returned by EventStore.Save for an event that has
+// already been processed
+var ErrDuplicateEvent = errors.New("webhook: duplicate event")
+
+// ErrEventInFlight is returned by EventStore.Save for an event that is being
+// processed, so that its outcome is not yet known
+var ErrEventInFlight = errors.New("webhook: event is being processed")
+
+// DefaultLease is how long an event may stay received before its processing
+// is taken to have been interrupted
+const DefaultLease = 5 * time.Minute
+
+// ErrUnknownEvent is returned by EventStore.Update for a key never saved
+var ErrUnknownEvent = errors.New("webhook: unknown event")
+
+// Status is the processing state of a stored event
+type Status string
+
+// Event processing states
+const (
+	// StatusReceived marks an event being processed, or one whose processing
+	// was interrupted, e.g. by a crash
+	StatusReceived  Status = "received"
+	StatusProcessed Status = "processed"
+	StatusFailed    Status = "failed"
+)
+
+// Record is an event as kept by an EventStore
+type Record struct {
+	Key        string    `json:"key"`
+	Type       EventType `json:"type"`
+	Body       []byte    `json:"body"`
+	Status     Status    `json:"status"`
+	Attempts   int       `json:"attempts"`
+	Error      string    `json:"error,omitempty"`
+	ReceivedAt time.Time `json:"received_at"`
+	// ClaimedAt is when the latest attempt at processing the event started
+	ClaimedAt   time.Time `json:"claimed_at,omitempty"`
+	ProcessedAt time.Time `json:"processed_at,omitempty"`
+}
+
+// claimed returns when the latest attempt started. Records written before
+// ClaimedAt existed fall back to ReceivedAt.
+func (r *Record) claimed() time.Time {
+	if r.ClaimedAt.IsZero() {
+		return r.ReceivedAt
+	}
+	return r.ClaimedAt
+}
+
+// Event parses the stored body back into an Event
+func (r *Record) Event() (*Event, error) {
+	return ParseEvent(r.Body)
+}
+
+// EventStore records webhook events so that redeliveries are recognised and
+// events can be replayed after an outage. Implementations must be safe for
+// concurrent use.
+type EventStore interface {
+	// Save claims the event for processing and records it as received.
+	// It returns ErrDuplicateEvent if an event with the same key has been
+	// processed, and ErrEventInFlight if one is being processed. Failed
+	// events, and received events whose lease has run out, may be claimed again.
+	Save(ctx context.Context, key string, e *Event) error
+
+	// Update records the outcome of processing the event stored under key.
+	// A nil handlerErr marks it processed.
+	Update(ctx context.Context, key string, handlerErr error) error
+
+	// Records returns the stored events in the order they were first received
+	Records(ctx context.Context) ([]Record, error)
+}
+
+// EventKey identifies an event for deduplication. Paystack does not send an
+// event ID, so the key combines the event type with the ID, or failing that
+// the reference or code, of the object it carries. Payloads without any of
+// these are keyed by a hash of the body.
+func EventKey(e *Event) string {
+	var data map[string]interface{}
+	json.Unmarshal(e.Data, &data)
+
+	for _, field := range []string{"id", "reference", "transfer_code", "subscription_code", "invoice_code", "request_code"} {
+		if v, ok := data[field]; ok && v != nil && v != "" {
+			return fmt.Sprintf("%s:%v", e.Type, v)
+		}
+	}
+	sum := sha256.Sum256(e.Raw)
+	return string(e.Type) + ":" + hex.EncodeToString(sum[:])
+}
+
+// MemoryStore is an EventStore keeping events in memory.
+// It suits tests and single-process deployments that can tolerate losing
+// their deduplication state on restart.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+	order   []string
+
+	// Lease is how long a received event is left to its current attempt
+	// before it may be claimed again, DefaultLease if zero
+	Lease time.Duration
+
+	// persist, if set, is called with every changed record while mu is held
+	persist func(*Record) error
+	now     func() time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]*Record), now: time.Now}
+}
+
+// Save implements EventStore
+func (s *MemoryStore) Save(ctx context.Context, key string, e *Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	rec, ok := s.records[key]
+	if ok {
+		switch {
+		case rec.Status == StatusProcessed:
+			return ErrDuplicateEvent
+		case rec.Status == StatusReceived && now.Sub(rec.claimed()) < s.lease():
+			return ErrEventInFlight
+		}
+	} else {
+		rec = &Record{Key: key, Type: e.Type, Body: e.Raw, ReceivedAt: now}
+	}
+	updated := *rec
+	updated.Status = StatusReceived
+	updated.ClaimedAt = now
+	updated.Attempts++
+	return s.put(&updated, !ok)
+}
+
+func (s *MemoryStore) lease() time.Duration {
+	if s.Lease > 0 {
+		return s.Lease
+	}
+	return DefaultLease
+}
+
+// Update implements EventStore
+func (s *MemoryStore) Update(ctx context.Context, key string, handlerErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.records[key]
+	if !ok {
+		return ErrUnknownEvent
+	}
+	updated := *rec
+	if handlerErr != nil {
+		updated.Status = StatusFailed
+		updated.Error = handlerErr.Error()
+	} else {
+		updated.Status = StatusProcessed
+		updated.Error = ""
+		updated.ProcessedAt = s.now()
+	}
+	return s.put(&updated, false)
+}
+
+// put persists and then stores rec; mu must be held
+func (s *MemoryStore) put(rec *Record, isNew bool) error {
+	if s.persist != nil {
+		if err := s.persist(rec); err != nil {
+			return err
+		}
+	}
+	s.load(rec, isNew)
+	return nil
+}
+
+// load stores rec without persisting it; mu must be held
+func (s *MemoryStore) load(rec *Record, isNew bool) {
+	if isNew {
+		s.order = append(s.order, rec.Key)
+	}
+	s.records[rec.Key] = rec
+}
+
+// Records implements EventStore
+func (s *MemoryStore) Records(ctx context.Context) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]Record, len(s.order))
+	for i, key := range s.order {
+		out[i] = *s.records[key]
+	}
+	return out, nil
+}
+
+// Unprocessed selects the records that Replay should run by default:
+// failed events and those whose processing was interrupted, that is
+// received events claimed more than DefaultLease ago. Events received more
+// recently may still be in flight and are left alone.
+func Unprocessed(r Record) bool {
+	return UnprocessedAfter(DefaultLease)(r)
+}
+
+// UnprocessedAfter is like Unprocessed but takes received events to be
+// interrupted once lease has passed, for stores with a different lease
+func UnprocessedAfter(lease time.Duration) func(Record) bool {
+	return func(r Record) bool {
+		switch r.Status {
+		case StatusFailed:
+			return true
+		case StatusReceived:
+			return time.Since(r.claimed()) >= lease
+		}
+		return false
+	}
+}
+
+// process dispatches e, consulting the store first if there is one.
+// Duplicates are reported with ErrDuplicateEvent.
+func (h *Handler) process(ctx context.Context, e *Event) error {
+	if h.Store == nil {
+		return h.Dispatch(ctx, e)
+	}
+	key := h.key(e)
+	if err := h.Store.Save(ctx, key, e); err != nil {
+		return err
+	}
+	err := h.Dispatch(ctx, e)
+	if uerr := h.Store.Update(ctx, key, err); uerr != nil && err == nil {
+		return uerr
+	}
+	return err
+}
+
+func (h *Handler) key(e *Event) string {
+	if h.KeyFunc != nil {
+		return h.KeyFunc(e)
+	}
+	return EventKey(e)
+}
+
+// Replay dispatches the stored events selected by filter again, in the order
+// they were received, recording each outcome in the store. A nil filter
+// selects Unprocessed events. Replay carries on past failing events and
+// returns the first error encountered.
+func (h *Handler) Replay(ctx context.Context, filter func(Record) bool) error {
+	if h.Store == nil {
+		return errors.New("webhook: handler has no event store")
+	}
+	if filter == nil {
+		filter = Unprocessed
+	}
+	records, err := h.Store.Records(ctx)
+	if err != nil {
+		return err
+	}
+
+	var first error
+	for _, rec := range records {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !filter(rec) {
+			continue
+		}
+		e, err := rec.Event()
+		if err == nil {
+			err = h.Dispatch(ctx, e)
+			if uerr := h.Store.Update(ctx, rec.Key, err); uerr != nil && err == nil {
+				err = uerr
+			}
+		}
+		if err != nil && first == nil {
+			first = fmt.Errorf("webhook: replaying %s: %w", rec.Key, err)
+		}
+	}
+	return first
+}
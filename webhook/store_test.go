@@ -0,0 +1,230 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHandlerDeduplicates(t *testing.T) {
+	calls := 0
+	h := NewHandler(testSecret)
+	h.Store = NewMemoryStore()
+	h.On(ChargeSuccess, func(ctx context.Context, e *Event) error {
+		calls++
+		return nil
+	})
+
+	for i := 0; i < 3; i++ {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, signedRequest(chargeSuccessBody))
+		if w.Code != http.StatusOK {
+			t.Fatalf("Delivery %d: expected 200, got %d", i+1, w.Code)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected callback to run once, ran %d times", calls)
+	}
+}
+
+func TestHandlerRetriesFailedEvents(t *testing.T) {
+	fail := true
+	calls := 0
+	h := NewHandler(testSecret)
+	h.Store = NewMemoryStore()
+	h.On(ChargeSuccess, func(ctx context.Context, e *Event) error {
+		calls++
+		if fail {
+			return errors.New("database down")
+		}
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(chargeSuccessBody))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected 500 for failing callback, got %d", w.Code)
+	}
+
+	fail = false
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(chargeSuccessBody))
+	if w.Code != http.StatusOK || calls != 2 {
+		t.Errorf("Expected redelivery to be processed, got %d after %d calls", w.Code, calls)
+	}
+
+	recs, _ := h.Store.Records(context.Background())
+	if len(recs) != 1 || recs[0].Status != StatusProcessed || recs[0].Attempts != 2 {
+		t.Errorf("Unexpected records %+v", recs)
+	}
+}
+
+func TestHandlerEventInFlight(t *testing.T) {
+	h := NewHandler(testSecret)
+	store := NewMemoryStore()
+	h.Store = store
+	redelivered := 0
+	h.On(ChargeSuccess, func(ctx context.Context, e *Event) error {
+		// Paystack redelivers while the first attempt is still running
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, signedRequest(chargeSuccessBody))
+		redelivered = w.Code
+		if err := h.Replay(ctx, nil); err != nil {
+			t.Error(err)
+		}
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(chargeSuccessBody))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", w.Code)
+	}
+	if redelivered != http.StatusConflict {
+		t.Errorf("Expected a redelivery in flight to get 409, got %d", redelivered)
+	}
+	recs, _ := store.Records(context.Background())
+	if len(recs) != 1 || recs[0].Attempts != 1 || recs[0].Status != StatusProcessed {
+		t.Errorf("Expected the event to run once, got %+v", recs)
+	}
+}
+
+func TestLeaseExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	e, _ := ParseEvent([]byte(chargeSuccessBody))
+
+	// the first attempt never reports back, e.g. the process crashed
+	if err := store.Save(ctx, "k1", e); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(ctx, "k1", e); !errors.Is(err, ErrEventInFlight) {
+		t.Errorf("Expected the event to be in flight, got %v", err)
+	}
+	if UnprocessedAfter(time.Minute)(Record{Status: StatusReceived, ClaimedAt: time.Now()}) {
+		t.Error("Expected a fresh claim not to be replayed")
+	}
+	if !UnprocessedAfter(time.Minute)(Record{Status: StatusReceived, ClaimedAt: time.Now().Add(-2 * time.Minute)}) {
+		t.Error("Expected a stale claim to be replayed")
+	}
+
+	now = now.Add(DefaultLease)
+	if err := store.Save(ctx, "k1", e); err != nil {
+		t.Errorf("Expected the stale claim to be taken over, got %v", err)
+	}
+	recs, _ := store.Records(ctx)
+	if len(recs) != 1 || recs[0].Attempts != 2 || !recs[0].ClaimedAt.Equal(now) {
+		t.Errorf("Unexpected records %+v", recs)
+	}
+}
+
+func TestHandlerReplay(t *testing.T) {
+	down := true
+	var handled []string
+	h := NewHandler(testSecret)
+	h.Store = NewMemoryStore()
+	h.OnUnhandled(func(ctx context.Context, e *Event) error {
+		if down {
+			return errors.New("downstream unavailable")
+		}
+		handled = append(handled, EventKey(e))
+		return nil
+	})
+
+	bodies := []string{
+		`{"event":"transfer.success","data":{"transfer_code":"TRF_1"}}`,
+		`{"event":"transfer.success","data":{"transfer_code":"TRF_2"}}`,
+	}
+	for _, body := range bodies {
+		h.ServeHTTP(httptest.NewRecorder(), signedRequest(body))
+	}
+
+	if err := h.Replay(context.Background(), nil); err == nil {
+		t.Error("Expected replay to report the outage")
+	}
+
+	down = false
+	if err := h.Replay(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(handled) != 2 || handled[0] != "transfer.success:TRF_1" || handled[1] != "transfer.success:TRF_2" {
+		t.Errorf("Expected both events replayed in order, got %v", handled)
+	}
+
+	handled = nil
+	if err := h.Replay(context.Background(), nil); err != nil || len(handled) != 0 {
+		t.Errorf("Expected processed events to be skipped, replayed %v", handled)
+	}
+}
+
+func TestEventKey(t *testing.T) {
+	cases := map[string]string{
+		chargeSuccessBody: "charge.success:302961",
+		`{"event":"subscription.disable","data":{"subscription_code":"SUB_1"}}`: "subscription.disable:SUB_1",
+	}
+	for body, want := range cases {
+		e, _ := ParseEvent([]byte(body))
+		if got := EventKey(e); got != want {
+			t.Errorf("Expected key %q, got %q", want, got)
+		}
+	}
+
+	a, _ := ParseEvent([]byte(`{"event":"customeridentification.failed","data":{"email":"a@b.co"}}`))
+	b, _ := ParseEvent([]byte(`{"event":"customeridentification.failed","data":{"email":"c@d.co"}}`))
+	if EventKey(a) == EventKey(b) {
+		t.Error("Expected events without identifiers to be keyed by body")
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.log")
+
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e1, _ := ParseEvent([]byte(chargeSuccessBody))
+	e2, _ := ParseEvent([]byte(`{"event":"transfer.failed","data":{"transfer_code":"TRF_9"}}`))
+
+	store.Save(ctx, "k1", e1)
+	store.Update(ctx, "k1", nil)
+	store.Save(ctx, "k2", e2)
+	store.Update(ctx, "k2", errors.New("boom"))
+	store.Close()
+
+	store, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	if err := store.Save(ctx, "k1", e1); !errors.Is(err, ErrDuplicateEvent) {
+		t.Errorf("Expected processed event to survive reopening, got %v", err)
+	}
+	recs, _ := store.Records(ctx)
+	if len(recs) != 2 || recs[1].Status != StatusFailed || recs[1].Error != "boom" {
+		t.Fatalf("Unexpected records after reopening %+v", recs)
+	}
+	if ev, err := recs[1].Event(); err != nil || ev.Type != TransferFailed {
+		t.Errorf("Expected stored body to parse back, got %v, %v", ev, err)
+	}
+
+	if err := store.Compact(time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	recs, _ = store.Records(ctx)
+	if len(recs) != 1 || recs[0].Key != "k2" {
+		t.Errorf("Expected compaction to drop processed events, got %+v", recs)
+	}
+
+	if err := store.Save(ctx, "k2", e2); err != nil {
+		t.Errorf("Expected failed event to be claimable after compaction, got %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+// Package webhook receives and verifies Paystack webhook events.
+//
+// Paystack signs every webhook with an HMAC-SHA512 of the request body,
+// keyed by the integration's secret key, and sends it in the
+// x-paystack-signature header. Handler checks the signature, parses the
+// event and dispatches it to the callbacks registered for its type:
+//
+//	h := webhook.NewHandler(secretKey)
+//	h.OnChargeSuccess(func(ctx context.Context, e *webhook.Event, txn *paystack.Transaction) error {
+//		return orders.MarkPaid(ctx, txn.Reference)
+//	})
+//	http.Handle("/paystack/webhook", h)
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	paystack "github.com/rpip/paystack-go"
+)
+
+// SignatureHeader is the header carrying the webhook signature
+const SignatureHeader = "X-Paystack-Signature"
+
+// defaultMaxBodyBytes caps the size of webhook bodies read by Handler
+const defaultMaxBodyBytes = 1 << 20
+
+// HandlerFunc handles a verified webhook event. Returning an error makes the
+// Handler answer with a 500 so that Paystack delivers the event again.
+type HandlerFunc func(ctx context.Context, e *Event) error
+
+// Handler is an http.Handler receiving Paystack webhooks
+type Handler struct {
+	secret   []byte
+	handlers map[EventType][]HandlerFunc
+	fallback HandlerFunc
+
+	// MaxBodyBytes limits the size of request bodies, 1MB if zero
+	MaxBodyBytes int64
+
+	// ErrorLog, if set, receives failures that are answered with an error status
+	ErrorLog paystack.Logger
+
+	// Store, if set, records events so that redeliveries of an event that
+	// was already processed are acknowledged without running the callbacks
+	// again, and so that events can be replayed with Replay
+	Store EventStore
+
+	// KeyFunc identifies events in the Store, EventKey if nil
+	KeyFunc func(*Event) string
+}
+
+// NewHandler returns a Handler verifying events with the given secret key
+func NewHandler(secretKey string) *Handler {
+	return &Handler{
+		secret:   []byte(secretKey),
+		handlers: make(map[EventType][]HandlerFunc),
+	}
+}
+
+// Sign returns the signature Paystack sends for body
+func Sign(secretKey string, body []byte) string {
+	mac := hmac.New(sha512.New, []byte(secretKey))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports, in constant time, whether signature is the valid
+// signature of body for the given secret key
+func VerifySignature(secretKey string, body []byte, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha512.New, []byte(secretKey))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// On registers fn for events of type t. Several callbacks may be registered
+// for the same type; they run in order until one fails. Handlers must be
+// registered before the Handler starts serving.
+func (h *Handler) On(t EventType, fn HandlerFunc) {
+	h.handlers[t] = append(h.handlers[t], fn)
+}
+
+// OnUnhandled registers fn for events without a callback of their own
+func (h *Handler) OnUnhandled(fn HandlerFunc) {
+	h.fallback = fn
+}
+
+// OnChargeSuccess registers fn for successful charges
+func (h *Handler) OnChargeSuccess(fn func(ctx context.Context, e *Event, txn *paystack.Transaction) error) {
+	h.On(ChargeSuccess, transactionFunc(fn))
+}
+
+// OnChargeDisputeCreate registers fn for disputes raised against a charge
+func (h *Handler) OnChargeDisputeCreate(fn func(ctx context.Context, e *Event, dispute *paystack.Dispute) error) {
+	h.On(ChargeDisputeCreate, disputeFunc(fn))
+}
+
+// OnChargeDisputeRemind registers fn for reminders about unanswered disputes
+func (h *Handler) OnChargeDisputeRemind(fn func(ctx context.Context, e *Event, dispute *paystack.Dispute) error) {
+	h.On(ChargeDisputeRemind, disputeFunc(fn))
+}
+
+// OnChargeDisputeResolve registers fn for resolved disputes
+func (h *Handler) OnChargeDisputeResolve(fn func(ctx context.Context, e *Event, dispute *paystack.Dispute) error) {
+	h.On(ChargeDisputeResolve, disputeFunc(fn))
+}
+
+// OnTransferSuccess registers fn for completed transfers
+func (h *Handler) OnTransferSuccess(fn func(ctx context.Context, e *Event, transfer *paystack.Transfer) error) {
+	h.On(TransferSuccess, transferFunc(fn))
+}
+
+// OnTransferFailed registers fn for failed transfers
+func (h *Handler) OnTransferFailed(fn func(ctx context.Context, e *Event, transfer *paystack.Transfer) error) {
+	h.On(TransferFailed, transferFunc(fn))
+}
+
+// OnTransferReversed registers fn for reversed transfers
+func (h *Handler) OnTransferReversed(fn func(ctx context.Context, e *Event, transfer *paystack.Transfer) error) {
+	h.On(TransferReversed, transferFunc(fn))
+}
+
+// OnSubscriptionCreate registers fn for new subscriptions
+func (h *Handler) OnSubscriptionCreate(fn func(ctx context.Context, e *Event, sub *paystack.Subscription) error) {
+	h.On(SubscriptionCreate, subscriptionFunc(fn))
+}
+
+// OnSubscriptionDisable registers fn for cancelled subscriptions
+func (h *Handler) OnSubscriptionDisable(fn func(ctx context.Context, e *Event, sub *paystack.Subscription) error) {
+	h.On(SubscriptionDisable, subscriptionFunc(fn))
+}
+
+// OnInvoiceCreate registers fn for invoices raised ahead of a subscription charge
+func (h *Handler) OnInvoiceCreate(fn func(ctx context.Context, e *Event, inv *Invoice) error) {
+	h.On(InvoiceCreate, invoiceFunc(fn))
+}
+
+// OnInvoiceUpdate registers fn for invoices updated after a subscription charge
+func (h *Handler) OnInvoiceUpdate(fn func(ctx context.Context, e *Event, inv *Invoice) error) {
+	h.On(InvoiceUpdate, invoiceFunc(fn))
+}
+
+// OnInvoicePaymentFailed registers fn for failed subscription charges
+func (h *Handler) OnInvoicePaymentFailed(fn func(ctx context.Context, e *Event, inv *Invoice) error) {
+	h.On(InvoicePaymentFailed, invoiceFunc(fn))
+}
+
+// OnDedicatedAccountAssignSuccess registers fn for dedicated accounts assigned to a customer
+func (h *Handler) OnDedicatedAccountAssignSuccess(fn func(ctx context.Context, e *Event, a *Assignment) error) {
+	h.On(DedicatedAccountAssignSuccess, assignmentFunc(fn))
+}
+
+// OnDedicatedAccountAssignFailed registers fn for dedicated accounts that could not be assigned
+func (h *Handler) OnDedicatedAccountAssignFailed(fn func(ctx context.Context, e *Event, a *Assignment) error) {
+	h.On(DedicatedAccountAssignFailed, assignmentFunc(fn))
+}
+
+func transactionFunc(fn func(context.Context, *Event, *paystack.Transaction) error) HandlerFunc {
+	return func(ctx context.Context, e *Event) error {
+		txn, err := e.Transaction()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, txn)
+	}
+}
+
+func disputeFunc(fn func(context.Context, *Event, *paystack.Dispute) error) HandlerFunc {
+	return func(ctx context.Context, e *Event) error {
+		dispute, err := e.Dispute()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, dispute)
+	}
+}
+
+func transferFunc(fn func(context.Context, *Event, *paystack.Transfer) error) HandlerFunc {
+	return func(ctx context.Context, e *Event) error {
+		transfer, err := e.Transfer()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, transfer)
+	}
+}
+
+func subscriptionFunc(fn func(context.Context, *Event, *paystack.Subscription) error) HandlerFunc {
+	return func(ctx context.Context, e *Event) error {
+		sub, err := e.Subscription()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, sub)
+	}
+}
+
+func invoiceFunc(fn func(context.Context, *Event, *Invoice) error) HandlerFunc {
+	return func(ctx context.Context, e *Event) error {
+		inv, err := e.Invoice()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, inv)
+	}
+}
+
+func assignmentFunc(fn func(context.Context, *Event, *Assignment) error) HandlerFunc {
+	return func(ctx context.Context, e *Event) error {
+		a, err := e.Assignment()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, a)
+	}
+}
+
+// Dispatch runs the callbacks registered for the event's type
+func (h *Handler) Dispatch(ctx context.Context, e *Event) error {
+	fns := h.handlers[e.Type]
+	if len(fns) == 0 && h.fallback != nil {
+		fns = []HandlerFunc{h.fallback}
+	}
+	for _, fn := range fns {
+		if err := fn(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServeHTTP verifies, parses and dispatches a webhook request. It answers
+// 401 for a bad signature, 400 for a malformed event, 409 for a redelivery
+// of an event still being processed, so that Paystack tries again later, and
+// 500 when a callback fails; events without callbacks and duplicates are
+// acknowledged with a 200.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	limit := h.MaxBodyBytes
+	if limit <= 0 {
+		limit = defaultMaxBodyBytes
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+	if err != nil {
+		h.logf("webhook: cannot read body: %v", err)
+		http.Error(w, "cannot read body", http.StatusBadRequest)
+		return
+	}
+
+	if !VerifySignature(string(h.secret), body, r.Header.Get(SignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	e, err := ParseEvent(body)
+	if err != nil {
+		h.logf("webhook: cannot parse event: %v", err)
+		http.Error(w, "malformed event", http.StatusBadRequest)
+		return
+	}
+
+	err = h.process(r.Context(), e)
+	if errors.Is(err, ErrDuplicateEvent) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if errors.Is(err, ErrEventInFlight) {
+		http.Error(w, "event is being processed", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		h.logf("webhook: %s handler failed: %v", e.Type, err)
+		http.Error(w, "event handler failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) logf(format string, v ...interface{}) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf(format, v...)
+	}
+}
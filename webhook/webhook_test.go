@@ -0,0 +1,196 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	paystack "github.com/rpip/paystack-go"
+)
+
+const testSecret = "sk_test_secret"
+
+const chargeSuccessBody = `{
+  "event": "charge.success",
+  "data": {
+    "id": 302961,
+    "domain": "live",
+    "status": "success",
+    "reference": "qTPrJoy9Bx",
+    "amount": 10000,
+    "gateway_response": "Approved by Financial Institution",
+    "channel": "card",
+    "currency": "NGN",
+    "metadata": {"cart_id": 398},
+    "customer": {"id": 68324, "email": "bojack@horsinaround.com", "customer_code": "CUS_qo38as2hpsgk2r0", "metadata": null},
+    "authorization": {"authorization_code": "AUTH_f5rnfq9p", "bin": "539999", "last4": "8877", "reusable": true},
+    "plan": {}
+  }
+}`
+
+func signedRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+	req.Header.Set(SignatureHeader, Sign(testSecret, []byte(body)))
+	return req
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(chargeSuccessBody)
+	sig := Sign(testSecret, body)
+
+	if !VerifySignature(testSecret, body, sig) {
+		t.Error("Expected signature to verify")
+	}
+	if VerifySignature("sk_test_other", body, sig) {
+		t.Error("Expected signature with another key to fail")
+	}
+	if VerifySignature(testSecret, append(body, ' '), sig) {
+		t.Error("Expected signature of a modified body to fail")
+	}
+	if VerifySignature(testSecret, body, "not-hex") {
+		t.Error("Expected malformed signature to fail")
+	}
+}
+
+func TestHandlerChargeSuccess(t *testing.T) {
+	var got *paystack.Transaction
+	h := NewHandler(testSecret)
+	h.OnChargeSuccess(func(ctx context.Context, e *Event, txn *paystack.Transaction) error {
+		got = txn
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(chargeSuccessBody))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body)
+	}
+	if got == nil {
+		t.Fatal("Expected charge.success callback to run")
+	}
+	if got.Reference != "qTPrJoy9Bx" || got.Amount != 10000 || got.Currency != paystack.NGN {
+		t.Errorf("Unexpected transaction %+v", got)
+	}
+	if got.Customer.CustomerCode != "CUS_qo38as2hpsgk2r0" || got.Authorization.AuthorizationCode != "AUTH_f5rnfq9p" {
+		t.Errorf("Unexpected nested objects %+v %+v", got.Customer, got.Authorization)
+	}
+	if got.Metadata != `{"cart_id":398}` {
+		t.Errorf("Expected metadata kept as JSON, got %q", got.Metadata)
+	}
+}
+
+func TestHandlerTransferFailed(t *testing.T) {
+	body := `{"event":"transfer.failed","data":{"amount":"30000","currency":"NGN","reference":"ref-1","transfer_code":"TRF_2x5j67tnnw1t98k","status":"failed","recipient":{"recipient_code":"RCP_1"}}}`
+
+	var got *paystack.Transfer
+	h := NewHandler(testSecret)
+	h.OnTransferFailed(func(ctx context.Context, e *Event, transfer *paystack.Transfer) error {
+		got = transfer
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(body))
+
+	if w.Code != http.StatusOK || got == nil {
+		t.Fatalf("Expected transfer.failed to be handled, got %d", w.Code)
+	}
+	if got.TransferCode != "TRF_2x5j67tnnw1t98k" || got.Amount != 30000 || got.Status != "failed" {
+		t.Errorf("Unexpected transfer %+v", got)
+	}
+}
+
+func TestHandlerChargeDisputeCreate(t *testing.T) {
+	body := `{"event":"charge.dispute.create","data":{"id":358950,"refund_amount":5000,"currency":"NGN","status":"awaiting-merchant-feedback","resolution":null,"category":"chargeback","transaction":{"id":908741,"reference":"ref-1","amount":5000},"customer":{"id":6378046,"email":"ada@example.com"},"evidence":null,"history":[{"status":"awaiting-merchant-feedback","by":"bank","createdAt":"2024-05-01T10:00:00.000Z"}],"dueAt":"2024-05-08T10:00:00.000Z"}}`
+
+	var got *paystack.Dispute
+	h := NewHandler(testSecret)
+	h.OnChargeDisputeCreate(func(ctx context.Context, e *Event, dispute *paystack.Dispute) error {
+		got = dispute
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(body))
+
+	if w.Code != http.StatusOK || got == nil {
+		t.Fatalf("Expected charge.dispute.create to be handled, got %d", w.Code)
+	}
+	if got.ID != 358950 || got.RefundAmount != 5000 || got.Status != paystack.DisputeAwaitingMerchantFeedback {
+		t.Errorf("Unexpected dispute %+v", got)
+	}
+	if got.Transaction.Reference != "ref-1" || got.Customer.Email != "ada@example.com" || len(got.History) != 1 {
+		t.Errorf("Unexpected nested objects %+v %+v %+v", got.Transaction, got.Customer, got.History)
+	}
+}
+
+func TestHandlerDedicatedAccountAssign(t *testing.T) {
+	body := `{"event":"dedicatedaccount.assign.success","data":{"customer":{"id":100110,"email":"ada@example.com","customer_code":"CUS_hcekca0j0bbg2m4"},"dedicated_account":{"id":180,"account_name":"KAROKART/ADA LOVELACE","account_number":"9930020212","assigned":true,"currency":"NGN","active":true,"bank":{"name":"Test Bank","id":200,"slug":"test-bank"},"assignment":{"assignee_id":100110,"assignee_type":"Customer","expired":false,"account_type":"PAY-WITH-TRANSFER-RECURRING"}},"identification":{"status":"success"}}}`
+
+	var got *Assignment
+	h := NewHandler(testSecret)
+	h.OnDedicatedAccountAssignSuccess(func(ctx context.Context, e *Event, a *Assignment) error {
+		got = a
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(body))
+
+	if w.Code != http.StatusOK || got == nil {
+		t.Fatalf("Expected dedicatedaccount.assign.success to be handled, got %d", w.Code)
+	}
+	if got.Customer.CustomerCode != "CUS_hcekca0j0bbg2m4" || got.Identification.Status != "success" {
+		t.Errorf("Unexpected assignment %+v", got)
+	}
+	if got.DedicatedAccount == nil || got.DedicatedAccount.AccountNumber != "9930020212" || got.DedicatedAccount.Bank.Slug != "test-bank" {
+		t.Errorf("Unexpected dedicated account %+v", got.DedicatedAccount)
+	}
+}
+
+func TestHandlerRejectsBadRequests(t *testing.T) {
+	h := NewHandler(testSecret)
+	h.On(ChargeSuccess, func(ctx context.Context, e *Event) error {
+		return errors.New("database down")
+	})
+
+	unsigned := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(chargeSuccessBody))
+	unsigned.Header.Set(SignatureHeader, Sign("sk_test_forged", []byte(chargeSuccessBody)))
+
+	cases := []struct {
+		name string
+		req  *http.Request
+		want int
+	}{
+		{"bad signature", unsigned, http.StatusUnauthorized},
+		{"wrong method", httptest.NewRequest(http.MethodGet, "/webhook", nil), http.StatusMethodNotAllowed},
+		{"malformed", signedRequest(`{"data":{}}`), http.StatusBadRequest},
+		{"callback error", signedRequest(chargeSuccessBody), http.StatusInternalServerError},
+		{"unhandled event", signedRequest(`{"event":"customeridentification.success","data":{}}`), http.StatusOK},
+	}
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, tc.req)
+		if w.Code != tc.want {
+			t.Errorf("%s: expected %d, got %d", tc.name, tc.want, w.Code)
+		}
+	}
+}
+
+func TestHandlerFallback(t *testing.T) {
+	var seen EventType
+	h := NewHandler(testSecret)
+	h.OnUnhandled(func(ctx context.Context, e *Event) error {
+		seen = e.Type
+		return nil
+	})
+
+	h.ServeHTTP(httptest.NewRecorder(), signedRequest(`{"event":"subscription.not_renew","data":{"subscription_code":"SUB_1"}}`))
+	if seen != SubscriptionNotRenew {
+		t.Errorf("Expected fallback to see subscription.not_renew, got %q", seen)
+	}
+}
@@ -0,0 +1,50 @@
+// Package webhooktest builds signed Paystack webhook requests for testing
+// webhook handlers, in the spirit of net/http/httptest.
+//
+//	txn := &paystack.Transaction{Reference: "ref-1", Amount: 50000, Status: "success"}
+//	req := webhooktest.NewRequest(secretKey, webhook.ChargeSuccess, txn)
+//	w := httptest.NewRecorder()
+//	handler.ServeHTTP(w, req)
+package webhooktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/rpip/paystack-go/webhook"
+)
+
+// DefaultTarget is the URL requests are addressed to
+const DefaultTarget = "/paystack/webhook"
+
+// Payload returns the body Paystack would send for an event of type t
+// carrying data, which is typically a struct from the paystack package
+// such as paystack.Transaction or paystack.Transfer.
+func Payload(t webhook.EventType, data interface{}) ([]byte, error) {
+	return json.Marshal(struct {
+		Event webhook.EventType `json:"event"`
+		Data  interface{}       `json:"data"`
+	}{t, data})
+}
+
+// NewRequest returns a webhook request for an event of type t carrying data,
+// signed with secretKey and arriving from one of the Paystack webhook
+// addresses. Like httptest.NewRequest it panics if data cannot be encoded.
+func NewRequest(secretKey string, t webhook.EventType, data interface{}) *http.Request {
+	body, err := Payload(t, data)
+	if err != nil {
+		panic("webhooktest: cannot encode event data: " + err.Error())
+	}
+	return NewRawRequest(secretKey, body)
+}
+
+// NewRawRequest returns a webhook request with the given body, signed with secretKey
+func NewRawRequest(secretKey string, body []byte) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, DefaultTarget, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(secretKey, body))
+	req.RemoteAddr = webhook.PaystackIPs[0] + ":443"
+	return req
+}
@@ -0,0 +1,63 @@
+package webhooktest
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	paystack "github.com/rpip/paystack-go"
+	"github.com/rpip/paystack-go/webhook"
+)
+
+const secret = "sk_test_secret"
+
+func TestNewRequestChargeSuccess(t *testing.T) {
+	h := webhook.NewHandler(secret)
+	var got *paystack.Transaction
+	h.OnChargeSuccess(func(ctx context.Context, e *webhook.Event, txn *paystack.Transaction) error {
+		got = txn
+		return nil
+	})
+
+	allow, _ := webhook.NewAllowList()
+	req := NewRequest(secret, webhook.ChargeSuccess, &paystack.Transaction{
+		Reference: "ref-123",
+		Amount:    50000,
+		Status:    "success",
+		Currency:  paystack.NGN,
+	})
+	w := httptest.NewRecorder()
+	allow.Wrap(h).ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("got status %d: %s", w.Code, w.Body)
+	}
+	if got == nil || got.Reference != "ref-123" || got.Amount != 50000 {
+		t.Errorf("unexpected transaction: %+v", got)
+	}
+}
+
+func TestNewRequestTransfer(t *testing.T) {
+	h := webhook.NewHandler(secret)
+	var code string
+	h.OnTransferFailed(func(ctx context.Context, e *webhook.Event, tr *paystack.Transfer) error {
+		code = tr.TransferCode
+		return nil
+	})
+	req := NewRequest(secret, webhook.TransferFailed, &paystack.Transfer{TransferCode: "TRF_1", Amount: 1000})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 200 || code != "TRF_1" {
+		t.Errorf("got status %d, transfer code %q", w.Code, code)
+	}
+}
+
+func TestNewRequestWrongSecret(t *testing.T) {
+	h := webhook.NewHandler(secret)
+	req := NewRequest("sk_test_other", webhook.ChargeSuccess, &paystack.Transaction{})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 401 {
+		t.Errorf("got status %d, want 401", w.Code)
+	}
+}